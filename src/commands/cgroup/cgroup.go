@@ -0,0 +1,165 @@
+package cgroup
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// Root is where the cgroup v2 hierarchy is mounted
+const Root = "/sys/fs/cgroup"
+
+// TreeCmd walks the cgroup v2 hierarchy and reads the accounting of every
+// cgroup. Nodes are returned depth-first with siblings sorted by name.
+func TreeCmd() tea.Cmd {
+	return func() tea.Msg {
+		if _, err := os.Stat(filepath.Join(Root, "cgroup.controllers")); err != nil {
+			return messages.CgroupsMsg{Err: fmt.Errorf("cgroup v2 is not mounted at %s", Root)}
+		}
+
+		var nodes []data.CgroupNode
+		err := filepath.WalkDir(Root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				// Cgroups come and go while walking; skip the ones that vanished
+				if d != nil && d.IsDir() && path != Root {
+					return fs.SkipDir
+				}
+				return nil
+			}
+			if !d.IsDir() {
+				return nil
+			}
+
+			node := readNode(path)
+			node.Path = strings.TrimPrefix(path, Root)
+			if node.Path == "" {
+				node.Path = "/"
+			} else {
+				node.Depth = strings.Count(node.Path, "/")
+			}
+			node.Name = filepath.Base(node.Path)
+			nodes = append(nodes, node)
+			return nil
+		})
+		if err != nil {
+			return messages.CgroupsMsg{Err: err}
+		}
+
+		for i := 0; i+1 < len(nodes); i++ {
+			nodes[i].HasChildren = nodes[i+1].Depth > nodes[i].Depth
+		}
+		return messages.CgroupsMsg{Nodes: nodes}
+	}
+}
+
+// readNode reads the accounting files of one cgroup directory. Files missing
+// for the root cgroup or for disabled controllers are left at zero.
+func readNode(dir string) data.CgroupNode {
+	node := data.CgroupNode{Time: time.Now()}
+
+	if cpu, err := ReadKeyedFile(filepath.Join(dir, "cpu.stat")); err == nil {
+		node.CPUUsageUsec = cpu["usage_usec"]
+	}
+	node.MemoryCurrent, _ = ReadUint(filepath.Join(dir, "memory.current"))
+	node.MemoryMax, _ = ReadUint(filepath.Join(dir, "memory.max"))
+	node.IOReadBytes, node.IOWriteBytes = ReadIOStat(filepath.Join(dir, "io.stat"))
+	node.PidsCurrent, _ = ReadUint(filepath.Join(dir, "pids.current"))
+	node.CpuPressure = ReadPressure(filepath.Join(dir, "cpu.pressure"))
+	node.MemoryPressure = ReadPressure(filepath.Join(dir, "memory.pressure"))
+	node.IOPressure = ReadPressure(filepath.Join(dir, "io.pressure"))
+	return node
+}
+
+// ReadUint reads a single-value file such as memory.current. A value of
+// "max" (no limit) reads as 0.
+func ReadUint(path string) (uint64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	value := strings.TrimSpace(string(content))
+	if value == "max" {
+		return 0, nil
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
+
+// ReadKeyedFile parses "key value" lines such as cpu.stat
+func ReadKeyedFile(path string) (map[string]uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	values := make(map[string]uint64)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		if v, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			values[fields[0]] = v
+		}
+	}
+	return values, scanner.Err()
+}
+
+// ReadIOStat sums rbytes and wbytes over every device in an io.stat file:
+// "8:0 rbytes=1459200 wbytes=314773504 rios=192 wios=353 ..."
+func ReadIOStat(path string) (read, write uint64) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, 0
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		for _, field := range strings.Fields(line) {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			v, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				continue
+			}
+			switch key {
+			case "rbytes":
+				read += v
+			case "wbytes":
+				write += v
+			}
+		}
+	}
+	return read, write
+}
+
+// ReadPressure returns the "some avg10" value of a PSI file:
+// "some avg10=0.00 avg60=0.00 avg300=0.00 total=0"
+func ReadPressure(path string) float64 {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "some" {
+			continue
+		}
+		if value, ok := strings.CutPrefix(fields[1], "avg10="); ok {
+			v, _ := strconv.ParseFloat(value, 64)
+			return v
+		}
+	}
+	return 0
+}
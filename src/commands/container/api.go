@@ -0,0 +1,175 @@
+package container
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// apiTimeout bounds an Engine API request. Stats requests wait for a
+// second sample, so they need more than a second. The deadline is set per
+// call through its context: actions such as stop take longer.
+const apiTimeout = 5 * time.Second
+
+// maxDetailWorkers bounds how many containers are inspected in parallel
+const maxDetailWorkers = 8
+
+// Client talks to the Docker Engine API, which Podman also serves
+type Client struct {
+	http *http.Client
+	base string
+}
+
+var (
+	clientsMutex sync.Mutex
+	clients      = make(map[string]*Client)
+)
+
+// ClientFor returns a cached client for an endpoint. The endpoint is a unix
+// socket path ("/var/run/docker.sock" or "unix:///run/podman/podman.sock")
+// or an HTTP URL ("http://127.0.0.1:2375"). An empty endpoint picks the
+// first Docker or Podman socket found.
+func ClientFor(endpoint string) *Client {
+	if endpoint == "" {
+		endpoint = DefaultSocket()
+	}
+
+	clientsMutex.Lock()
+	defer clientsMutex.Unlock()
+	if c, ok := clients[endpoint]; ok {
+		return c
+	}
+
+	c := &Client{http: &http.Client{}}
+	if strings.HasPrefix(endpoint, "http://") || strings.HasPrefix(endpoint, "https://") {
+		c.base = strings.TrimSuffix(endpoint, "/")
+	} else {
+		socket := strings.TrimPrefix(endpoint, "unix://")
+		c.base = "http://docker"
+		c.http.Transport = &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socket)
+			},
+		}
+	}
+	clients[endpoint] = c
+	return c
+}
+
+// DefaultSocket returns the first Docker or Podman API socket that exists
+func DefaultSocket() string {
+	candidates := []string{"/var/run/docker.sock", "/run/podman/podman.sock"}
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		candidates = append(candidates, filepath.Join(dir, "podman", "podman.sock"), filepath.Join(dir, "docker.sock"))
+	}
+	for _, c := range candidates {
+		if _, err := os.Stat(c); err == nil {
+			return c
+		}
+	}
+	return candidates[0]
+}
+
+// apiContainer is an entry of GET /containers/json
+type apiContainer struct {
+	ID      string   `json:"Id"`
+	Names   []string `json:"Names"`
+	Image   string   `json:"Image"`
+	State   string   `json:"State"`
+	Status  string   `json:"Status"`
+	Created int64    `json:"Created"`
+}
+
+// apiInspect holds the fields used from GET /containers/{id}/json
+type apiInspect struct {
+	RestartCount int `json:"RestartCount"`
+	State        struct {
+		StartedAt time.Time `json:"StartedAt"`
+	} `json:"State"`
+}
+
+// apiStats holds the fields used from GET /containers/{id}/stats
+type apiStats struct {
+	CPUStats    apiCPUStats `json:"cpu_stats"`
+	PreCPUStats apiCPUStats `json:"precpu_stats"`
+	MemoryStats struct {
+		Usage uint64            `json:"usage"`
+		Limit uint64            `json:"limit"`
+		Stats map[string]uint64 `json:"stats"`
+	} `json:"memory_stats"`
+	Networks map[string]struct {
+		RxBytes uint64 `json:"rx_bytes"`
+		TxBytes uint64 `json:"tx_bytes"`
+	} `json:"networks"`
+	BlkioStats struct {
+		IOServiceBytesRecursive []struct {
+			Op    string `json:"op"`
+			Value uint64 `json:"value"`
+		} `json:"io_service_bytes_recursive"`
+	} `json:"blkio_stats"`
+}
+
+type apiCPUStats struct {
+	CPUUsage struct {
+		TotalUsage  uint64   `json:"total_usage"`
+		PercpuUsage []uint64 `json:"percpu_usage"`
+	} `json:"cpu_usage"`
+	SystemUsage uint64 `json:"system_cpu_usage"`
+	OnlineCPUs  int    `json:"online_cpus"`
+}
+
+// get decodes the JSON response of a GET request into v
+func (c *Client) get(ctx context.Context, path string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.base+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return apiError(resp)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// post sends a POST request without a body
+func (c *Client) post(ctx context.Context, path string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.base+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// 304: container already started/stopped
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotModified && resp.StatusCode != http.StatusOK {
+		return apiError(resp)
+	}
+	return nil
+}
+
+// apiError turns an error response into an error, using the API's message when present
+func apiError(resp *http.Response) error {
+	var body struct {
+		Message string `json:"message"`
+	}
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if json.Unmarshal(raw, &body) == nil && body.Message != "" {
+		return fmt.Errorf("%s (HTTP %d)", body.Message, resp.StatusCode)
+	}
+	return fmt.Errorf("HTTP %d", resp.StatusCode)
+}
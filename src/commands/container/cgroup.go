@@ -0,0 +1,237 @@
+package container
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/commands/cgroup"
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// nameRetry is how long an unresolved container name is cached before
+// looking it up again
+const nameRetry = 30 * time.Second
+
+// Ref identifies the container a cgroup belongs to
+type Ref struct {
+	ID      string // Full 64-character ID
+	Runtime string // "docker", "podman", "containerd" or "cri-o"
+	Cgroup  string // Path of the container's own cgroup
+}
+
+// ShortID returns the 12-character ID shown by docker ps
+func (r Ref) ShortID() string {
+	if len(r.ID) > 12 {
+		return r.ID[:12]
+	}
+	return r.ID
+}
+
+// runtimePrefixes maps cgroup path segment prefixes to container runtimes.
+// Covers the systemd (docker-<id>.scope) and cgroupfs (/docker/<id>) drivers.
+var runtimePrefixes = []struct {
+	prefix  string
+	runtime string
+}{
+	{"docker-", "docker"},
+	{"libpod-", "podman"},
+	{"cri-containerd-", "containerd"},
+	{"crio-", "cri-o"},
+}
+
+// ParseCgroup extracts the container from a process cgroup path such as
+// /system.slice/docker-<id>.scope or /docker/<id>. ok is false for
+// processes that do not run in a container.
+func ParseCgroup(path string) (ref Ref, ok bool) {
+	segments := strings.Split(path, "/")
+	// The innermost container wins, so scan from the leaf up
+	for i := len(segments) - 1; i >= 0; i-- {
+		seg := strings.TrimSuffix(segments[i], ".scope")
+		// conmon is podman's monitor process, not part of the container
+		if strings.HasPrefix(seg, "libpod-conmon-") {
+			return Ref{}, false
+		}
+
+		runtime := ""
+		for _, rp := range runtimePrefixes {
+			if strings.HasPrefix(seg, rp.prefix) {
+				runtime = rp.runtime
+				seg = seg[len(rp.prefix):]
+				break
+			}
+		}
+		if !isContainerID(seg) {
+			continue
+		}
+		if runtime == "" && i > 0 {
+			// cgroupfs driver: the parent directory names the runtime
+			switch segments[i-1] {
+			case "docker":
+				runtime = "docker"
+			case "libpod_parent":
+				runtime = "podman"
+			default:
+				runtime = "containerd"
+			}
+		}
+		return Ref{ID: seg, Runtime: runtime, Cgroup: strings.Join(segments[:i+1], "/")}, true
+	}
+	return Ref{}, false
+}
+
+// isContainerID reports whether s is a 64-character hex ID
+func isContainerID(s string) bool {
+	if len(s) != 64 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	namesMutex sync.Mutex
+	names      = make(map[string]string)    // ID -> name
+	nameMisses = make(map[string]time.Time) // ID -> last failed lookup
+)
+
+// Name resolves a container ID to its name, falling back to the short ID.
+// Lookups read the runtime's on-disk state and are cached.
+func Name(ref Ref) string {
+	namesMutex.Lock()
+	defer namesMutex.Unlock()
+
+	if name, ok := names[ref.ID]; ok {
+		return name
+	}
+	if missed, ok := nameMisses[ref.ID]; ok && time.Since(missed) < nameRetry {
+		return ref.ShortID()
+	}
+
+	var name string
+	switch ref.Runtime {
+	case "docker":
+		name = dockerName(ref.ID)
+	case "podman":
+		name = podmanName(ref.ID)
+	}
+	if name == "" {
+		nameMisses[ref.ID] = time.Now()
+		return ref.ShortID()
+	}
+	names[ref.ID] = name
+	delete(nameMisses, ref.ID)
+	return name
+}
+
+// SetName records a name learned elsewhere (e.g. from the Engine API)
+func SetName(id, name string) {
+	namesMutex.Lock()
+	defer namesMutex.Unlock()
+	names[id] = name
+	delete(nameMisses, id)
+}
+
+// dockerName reads the name from Docker's container config (root only)
+func dockerName(id string) string {
+	content, err := os.ReadFile(filepath.Join("/var/lib/docker/containers", id, "config.v2.json"))
+	if err != nil {
+		return ""
+	}
+	var cfg struct {
+		Name string `json:"Name"`
+	}
+	if json.Unmarshal(content, &cfg) != nil {
+		return ""
+	}
+	return strings.TrimPrefix(cfg.Name, "/")
+}
+
+// podmanName looks the ID up in the rootful and rootless container stores
+func podmanName(id string) string {
+	stores := []string{"/var/lib/containers/storage/overlay-containers/containers.json"}
+	if home, err := os.UserHomeDir(); err == nil {
+		stores = append(stores, filepath.Join(home, ".local/share/containers/storage/overlay-containers/containers.json"))
+	}
+	for _, store := range stores {
+		content, err := os.ReadFile(store)
+		if err != nil {
+			continue
+		}
+		var containers []struct {
+			ID    string   `json:"id"`
+			Names []string `json:"names"`
+		}
+		if json.Unmarshal(content, &containers) != nil {
+			continue
+		}
+		for _, c := range containers {
+			if c.ID == id && len(c.Names) > 0 {
+				return c.Names[0]
+			}
+		}
+	}
+	return ""
+}
+
+// StatsCmd reads cgroup v2 accounting for each container. Containers whose
+// files cannot be read (e.g. cgroup v1 hosts) are left out.
+func StatsCmd(refs map[string]Ref) tea.Cmd {
+	return func() tea.Msg {
+		stats := make(map[string]data.ContainerStats, len(refs))
+		for id, ref := range refs {
+			st, err := readCgroupStats(filepath.Join(cgroup.Root, ref.Cgroup))
+			if err != nil {
+				continue
+			}
+			st.ID = id
+			st.Runtime = ref.Runtime
+			st.Cgroup = ref.Cgroup
+			stats[id] = st
+		}
+		return messages.ContainerStatsMsg(stats)
+	}
+}
+
+// readCgroupStats reads cpu.stat, memory.current and io.stat of a cgroup directory
+func readCgroupStats(dir string) (data.ContainerStats, error) {
+	st := data.ContainerStats{Time: time.Now()}
+
+	cpu, err := cgroup.ReadKeyedFile(filepath.Join(dir, "cpu.stat"))
+	if err != nil {
+		return st, err
+	}
+	st.CPUUsageUsec = cpu["usage_usec"]
+
+	st.MemoryCurrent, _ = cgroup.ReadUint(filepath.Join(dir, "memory.current"))
+	st.IOReadBytes, st.IOWriteBytes = cgroup.ReadIOStat(filepath.Join(dir, "io.stat"))
+	return st, nil
+}
+
+// RefsFromProcesses returns the containers the given processes run in, by ID
+func RefsFromProcesses(procs []data.ProcessInfo) map[string]Ref {
+	refs := make(map[string]Ref)
+	for _, p := range procs {
+		if p.ContainerID == "" {
+			continue
+		}
+		if _, ok := refs[p.ContainerID]; ok {
+			continue
+		}
+		if ref, ok := ParseCgroup(p.Cgroup); ok {
+			refs[ref.ID] = ref
+		}
+	}
+	return refs
+}
@@ -0,0 +1,153 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// ListCmd lists all containers with their stats from the Engine API at endpoint
+func ListCmd(endpoint string) tea.Cmd {
+	return func() tea.Msg {
+		c := ClientFor(endpoint)
+		containers, err := c.list()
+		if err != nil {
+			return messages.ContainersMsg{Err: err}
+		}
+
+		// Inspect and stats are per container; fetch them with a bounded set
+		// of workers, each container with its own deadline
+		var next int64 = -1
+		var wg sync.WaitGroup
+		for w := 0; w < min(maxDetailWorkers, len(containers)); w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					i := int(atomic.AddInt64(&next, 1))
+					if i >= len(containers) {
+						return
+					}
+					ctx, cancel := context.WithTimeout(context.Background(), apiTimeout)
+					c.fillDetails(ctx, &containers[i])
+					cancel()
+				}
+			}()
+		}
+		wg.Wait()
+
+		return messages.ContainersMsg{Containers: containers}
+	}
+}
+
+// NamesCmd refreshes the container names shown in the process list from
+// the Engine API, without the per-container stats ListCmd fetches
+func NamesCmd(endpoint string) tea.Cmd {
+	return func() tea.Msg {
+		ClientFor(endpoint).list()
+		return nil
+	}
+}
+
+// list returns every container without its details and records the names
+func (c *Client) list() ([]data.Container, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), apiTimeout)
+	defer cancel()
+
+	var list []apiContainer
+	if err := c.get(ctx, "/containers/json?all=1", &list); err != nil {
+		return nil, err
+	}
+
+	containers := make([]data.Container, len(list))
+	for i, ac := range list {
+		containers[i] = data.Container{
+			ID:     ac.ID,
+			Image:  ac.Image,
+			State:  ac.State,
+			Status: ac.Status,
+		}
+		if len(ac.Names) > 0 {
+			containers[i].Name = strings.TrimPrefix(ac.Names[0], "/")
+			SetName(ac.ID, containers[i].Name)
+		}
+	}
+	return containers, nil
+}
+
+// fillDetails adds the restart count, start time and resource usage of a container
+func (c *Client) fillDetails(ctx context.Context, ct *data.Container) {
+	var inspect apiInspect
+	if err := c.get(ctx, "/containers/"+ct.ID+"/json", &inspect); err == nil {
+		ct.RestartCount = inspect.RestartCount
+		ct.StartedAt = inspect.State.StartedAt
+	}
+
+	if ct.State != "running" {
+		return
+	}
+
+	// stream=false waits for a second sample so precpu_stats is filled in
+	var st apiStats
+	if err := c.get(ctx, "/containers/"+ct.ID+"/stats?stream=false", &st); err != nil {
+		return
+	}
+
+	cpuDelta := float64(st.CPUStats.CPUUsage.TotalUsage) - float64(st.PreCPUStats.CPUUsage.TotalUsage)
+	sysDelta := float64(st.CPUStats.SystemUsage) - float64(st.PreCPUStats.SystemUsage)
+	online := st.CPUStats.OnlineCPUs
+	if online == 0 {
+		online = len(st.CPUStats.CPUUsage.PercpuUsage)
+	}
+	if cpuDelta > 0 && sysDelta > 0 {
+		ct.CpuPercent = cpuDelta / sysDelta * float64(online) * 100
+	}
+
+	// Match docker stats: page cache that can be reclaimed is not counted
+	ct.MemUsage = st.MemoryStats.Usage
+	inactive := st.MemoryStats.Stats["inactive_file"] // cgroup v2
+	if inactive == 0 {
+		inactive = st.MemoryStats.Stats["total_inactive_file"] // cgroup v1
+	}
+	if inactive < ct.MemUsage {
+		ct.MemUsage -= inactive
+	}
+	ct.MemLimit = st.MemoryStats.Limit
+
+	for _, n := range st.Networks {
+		ct.NetRx += n.RxBytes
+		ct.NetTx += n.TxBytes
+	}
+	for _, b := range st.BlkioStats.IOServiceBytesRecursive {
+		switch strings.ToLower(b.Op) {
+		case "read":
+			ct.BlockRead += b.Value
+		case "write":
+			ct.BlockWrite += b.Value
+		}
+	}
+}
+
+// ActionCmd starts, stops, restarts, pauses or unpauses a container
+func ActionCmd(endpoint string, ct data.Container, action string) tea.Cmd {
+	return func() tea.Msg {
+		switch action {
+		case "start", "stop", "restart", "pause", "unpause":
+		default:
+			return messages.ContainerActionMsg{ID: ct.ID, Name: ct.Name, Action: action, Err: fmt.Errorf("unknown action %q", action)}
+		}
+
+		// Stopping waits for the container's grace period
+		ctx, cancel := context.WithTimeout(context.Background(), 3*apiTimeout)
+		defer cancel()
+		err := ClientFor(endpoint).post(ctx, "/containers/"+ct.ID+"/"+action)
+		return messages.ContainerActionMsg{ID: ct.ID, Name: ct.Name, Action: action, Err: err}
+	}
+}
@@ -0,0 +1,284 @@
+package netstat
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+	"github.com/N1xev/bubbleMonitor/src/utils"
+)
+
+// tcpStates maps the hex state column of /proc/net/tcp to its name
+var tcpStates = map[string]string{
+	"01": "ESTABLISHED",
+	"02": "SYN_SENT",
+	"03": "SYN_RECV",
+	"04": "FIN_WAIT1",
+	"05": "FIN_WAIT2",
+	"06": "TIME_WAIT",
+	"07": "CLOSE",
+	"08": "CLOSE_WAIT",
+	"09": "LAST_ACK",
+	"0A": "LISTEN",
+	"0B": "CLOSING",
+}
+
+// ConnectionsCmd lists TCP, UDP and unix sockets with their owning processes
+func ConnectionsCmd() tea.Cmd {
+	return func() tea.Msg {
+		conns, err := ListConnections()
+		return messages.ConnectionsMsg{Connections: conns, Err: err}
+	}
+}
+
+// ListConnections parses /proc/net/{tcp,tcp6,udp,udp6,unix} and maps each
+// socket to its process through the socket inodes in /proc/<pid>/fd.
+// Without root, sockets of other users' processes have no owner.
+func ListConnections() ([]data.Connection, error) {
+	var conns []data.Connection
+	read := 0
+	for _, proto := range []string{"tcp", "tcp6", "udp", "udp6"} {
+		content, err := os.ReadFile("/proc/net/" + proto)
+		if err != nil {
+			continue
+		}
+		read++
+		conns = append(conns, parseInet(string(content), proto)...)
+	}
+	if content, err := os.ReadFile("/proc/net/unix"); err == nil {
+		read++
+		conns = append(conns, parseUnix(string(content))...)
+	}
+	if read == 0 {
+		return nil, fmt.Errorf("cannot read /proc/net; listing sockets needs Linux")
+	}
+
+	owners := socketOwners()
+	procs := make(map[int32]procInfo)
+	for i := range conns {
+		c := &conns[i]
+		pid, ok := owners[c.Inode]
+		if !ok {
+			if c.Uid >= 0 {
+				c.User = utils.LookupUsername(strconv.Itoa(c.Uid))
+			}
+			continue
+		}
+		info, ok := procs[pid]
+		if !ok {
+			info = readProcInfo(pid)
+			procs[pid] = info
+		}
+		c.Pid = pid
+		c.Process = info.name
+		uid := c.Uid
+		if uid < 0 {
+			uid = info.uid
+		}
+		if uid >= 0 {
+			c.User = utils.LookupUsername(strconv.Itoa(uid))
+		}
+	}
+
+	sort.SliceStable(conns, func(i, j int) bool {
+		if conns[i].Proto != conns[j].Proto {
+			return conns[i].Proto < conns[j].Proto
+		}
+		return conns[i].LocalPort < conns[j].LocalPort
+	})
+	return conns, nil
+}
+
+// parseInet parses /proc/net/tcp, tcp6, udp or udp6:
+//
+//	sl  local_address rem_address   st tx_queue:rx_queue tr tm->when retrnsmt   uid  timeout inode
+//	 0: 0100007F:0CEA 00000000:0000 0A 00000000:00000000 00:00000000 00000000  1000        0 23456 ...
+func parseInet(content, proto string) []data.Connection {
+	udp := strings.HasPrefix(proto, "udp")
+	var conns []data.Connection
+	for _, line := range strings.Split(content, "\n")[1:] {
+		fields := strings.Fields(line)
+		if len(fields) < 10 {
+			continue
+		}
+		local, lport, err := parseHexAddr(fields[1])
+		if err != nil {
+			continue
+		}
+		remote, rport, err := parseHexAddr(fields[2])
+		if err != nil {
+			continue
+		}
+		state := tcpStates[fields[3]]
+		if udp {
+			// UDP reuses the TCP codes: 07 is an unconnected socket
+			if fields[3] == "07" {
+				state = "UNCONN"
+			}
+		}
+		txq, rxq, _ := strings.Cut(fields[4], ":")
+		c := data.Connection{
+			Proto:      proto,
+			LocalAddr:  joinHostPort(local, lport),
+			LocalPort:  lport,
+			RemoteAddr: joinHostPort(remote, rport),
+			RemotePort: rport,
+			State:      state,
+		}
+		c.TxQueue, _ = strconv.ParseUint(txq, 16, 64)
+		c.RxQueue, _ = strconv.ParseUint(rxq, 16, 64)
+		c.Uid, _ = strconv.Atoi(fields[7])
+		c.Inode, _ = strconv.ParseUint(fields[9], 10, 64)
+		conns = append(conns, c)
+	}
+	return conns
+}
+
+// parseUnix parses /proc/net/unix:
+//
+//	Num       RefCount Protocol Flags    Type St Inode Path
+//	0000000000000000: 00000002 00000000 00010000 0001 01 21483 /run/systemd/private
+func parseUnix(content string) []data.Connection {
+	var conns []data.Connection
+	for _, line := range strings.Split(content, "\n")[1:] {
+		fields := strings.Fields(line)
+		if len(fields) < 7 {
+			continue
+		}
+		flags, _ := strconv.ParseUint(fields[3], 16, 32)
+		state := "UNCONN"
+		switch {
+		case flags&0x10000 != 0: // __SO_ACCEPTCON
+			state = "LISTEN"
+		case fields[5] == "03":
+			state = "CONNECTED"
+		case fields[5] == "02":
+			state = "CONNECTING"
+		case fields[5] == "04":
+			state = "DISCONNECTING"
+		}
+		c := data.Connection{Proto: "unix", State: state, Uid: -1}
+		c.Inode, _ = strconv.ParseUint(fields[6], 10, 64)
+		if len(fields) > 7 {
+			c.LocalAddr = fields[7]
+		}
+		conns = append(conns, c)
+	}
+	return conns
+}
+
+// parseHexAddr decodes "0100007F:0CEA" (IPv4) or a 32-digit IPv6 address,
+// both stored as host-endian 32-bit words
+func parseHexAddr(s string) (net.IP, uint16, error) {
+	addr, port, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil, 0, fmt.Errorf("bad address %q", s)
+	}
+	raw, err := hex.DecodeString(addr)
+	if err != nil || (len(raw) != 4 && len(raw) != 16) {
+		return nil, 0, fmt.Errorf("bad address %q", s)
+	}
+	ip := make(net.IP, len(raw))
+	for i := 0; i < len(raw); i += 4 {
+		binary.BigEndian.PutUint32(ip[i:], binary.LittleEndian.Uint32(raw[i:]))
+	}
+	p, err := strconv.ParseUint(port, 16, 16)
+	if err != nil {
+		return nil, 0, fmt.Errorf("bad port %q", port)
+	}
+	return ip, uint16(p), nil
+}
+
+// joinHostPort formats an address, using * for the unspecified address
+func joinHostPort(ip net.IP, port uint16) string {
+	host := ip.String()
+	if ip.IsUnspecified() {
+		host = "*"
+	} else if ip.To4() == nil {
+		host = "[" + host + "]"
+	}
+	if port == 0 {
+		return host + ":*"
+	}
+	return host + ":" + strconv.Itoa(int(port))
+}
+
+// socketOwners maps socket inodes to the PID holding them open. A socket
+// shared by several processes is attributed to the first one found.
+func socketOwners() map[uint64]int32 {
+	owners := make(map[uint64]int32)
+	for _, pid := range listPids() {
+		addSocketOwners(owners, pid)
+	}
+	return owners
+}
+
+// listPids lists the PIDs under /proc
+func listPids() []int32 {
+	dirs, _ := filepath.Glob("/proc/[0-9]*")
+	pids := make([]int32, 0, len(dirs))
+	for _, dir := range dirs {
+		if pid, err := strconv.Atoi(filepath.Base(dir)); err == nil {
+			pids = append(pids, int32(pid))
+		}
+	}
+	return pids
+}
+
+// addSocketOwners records the sockets a process holds open, keeping owners
+// already recorded
+func addSocketOwners(owners map[uint64]int32, pid int32) {
+	dir := filepath.Join("/proc", strconv.Itoa(int(pid)), "fd")
+	fds, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, fd := range fds {
+		target, err := os.Readlink(filepath.Join(dir, fd.Name()))
+		if err != nil || !strings.HasPrefix(target, "socket:[") {
+			continue
+		}
+		inode, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(target, "socket:["), "]"), 10, 64)
+		if err != nil {
+			continue
+		}
+		if _, seen := owners[inode]; !seen {
+			owners[inode] = pid
+		}
+	}
+}
+
+type procInfo struct {
+	name string
+	uid  int
+}
+
+// readProcInfo reads a process's name and real uid
+func readProcInfo(pid int32) procInfo {
+	info := procInfo{uid: -1}
+	base := filepath.Join("/proc", strconv.Itoa(int(pid)))
+	if comm, err := os.ReadFile(filepath.Join(base, "comm")); err == nil {
+		info.name = strings.TrimSpace(string(comm))
+	}
+	if status, err := os.ReadFile(filepath.Join(base, "status")); err == nil {
+		for _, line := range strings.Split(string(status), "\n") {
+			if rest, ok := strings.CutPrefix(line, "Uid:"); ok {
+				if fields := strings.Fields(rest); len(fields) > 0 {
+					info.uid, _ = strconv.Atoi(fields[0])
+				}
+				break
+			}
+		}
+	}
+	return info
+}
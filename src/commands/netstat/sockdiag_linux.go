@@ -0,0 +1,126 @@
+package netstat
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"syscall"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+)
+
+const (
+	sockDiagByFamily = 20 // SOCK_DIAG_BY_FAMILY
+	inetDiagInfo     = 2  // INET_DIAG_INFO attribute, carries struct tcp_info
+
+	inetDiagReqLen = 56 // struct inet_diag_req_v2
+	inetDiagMsgLen = 72 // struct inet_diag_msg
+	inetDiagInode  = 68 // Offset of idiag_inode in inet_diag_msg
+
+	// Offsets of the u64 counters in struct tcp_info (Linux 4.1+)
+	tcpInfoBytesAcked    = 120
+	tcpInfoBytesReceived = 128
+)
+
+// tcpSocketBytes dumps every IPv4 and IPv6 TCP socket through the sock_diag
+// netlink interface and returns the bytes received and acknowledged by the
+// peer on each, keyed by socket inode. Sockets in TIME_WAIT carry no
+// tcp_info and are left out.
+func tcpSocketBytes() (map[uint64]data.SocketBytes, error) {
+	fd, err := syscall.Socket(syscall.AF_NETLINK, syscall.SOCK_DGRAM|syscall.SOCK_CLOEXEC, syscall.NETLINK_INET_DIAG)
+	if err != nil {
+		return nil, fmt.Errorf("sock_diag: %w", err)
+	}
+	defer syscall.Close(fd)
+
+	if err := syscall.Bind(fd, &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK}); err != nil {
+		return nil, fmt.Errorf("sock_diag: %w", err)
+	}
+
+	sockets := make(map[uint64]data.SocketBytes)
+	for seq, family := range []uint8{syscall.AF_INET, syscall.AF_INET6} {
+		if err := dumpTCP(fd, family, uint32(seq+1), sockets); err != nil {
+			return nil, err
+		}
+	}
+	return sockets, nil
+}
+
+// dumpTCP sends one inet_diag dump request and reads the replies until the
+// kernel signals the end of the dump
+func dumpTCP(fd int, family uint8, seq uint32, sockets map[uint64]data.SocketBytes) error {
+	req := make([]byte, syscall.NLMSG_HDRLEN+inetDiagReqLen)
+	ne := binary.NativeEndian
+	ne.PutUint32(req[0:], uint32(len(req)))
+	ne.PutUint16(req[4:], sockDiagByFamily)
+	ne.PutUint16(req[6:], syscall.NLM_F_REQUEST|syscall.NLM_F_DUMP)
+	ne.PutUint32(req[8:], seq)
+	body := req[syscall.NLMSG_HDRLEN:]
+	body[0] = family
+	body[1] = syscall.IPPROTO_TCP
+	body[2] = 1 << (inetDiagInfo - 1)  // Ask for tcp_info
+	ne.PutUint32(body[4:], 0xFFFFFFFF) // All states
+
+	if err := syscall.Sendto(fd, req, 0, &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK}); err != nil {
+		return fmt.Errorf("sock_diag: %w", err)
+	}
+
+	buf := make([]byte, 16*os.Getpagesize())
+	for {
+		n, _, err := syscall.Recvfrom(fd, buf, 0)
+		if err != nil {
+			return fmt.Errorf("sock_diag: %w", err)
+		}
+		msgs, err := syscall.ParseNetlinkMessage(buf[:n])
+		if err != nil {
+			return fmt.Errorf("sock_diag: %w", err)
+		}
+		for _, msg := range msgs {
+			if msg.Header.Seq != seq {
+				continue
+			}
+			switch msg.Header.Type {
+			case syscall.NLMSG_DONE:
+				return nil
+			case syscall.NLMSG_ERROR:
+				if len(msg.Data) >= 4 {
+					if errno := int32(ne.Uint32(msg.Data)); errno != 0 {
+						return fmt.Errorf("sock_diag: %w", syscall.Errno(-errno))
+					}
+				}
+				return nil
+			}
+			if len(msg.Data) < inetDiagMsgLen {
+				continue
+			}
+			inode := uint64(ne.Uint32(msg.Data[inetDiagInode:]))
+			if info := diagAttr(msg.Data[inetDiagMsgLen:], inetDiagInfo); len(info) >= tcpInfoBytesReceived+8 {
+				sockets[inode] = data.SocketBytes{
+					Rx: ne.Uint64(info[tcpInfoBytesReceived:]),
+					Tx: ne.Uint64(info[tcpInfoBytesAcked:]),
+				}
+			}
+		}
+	}
+}
+
+// diagAttr returns the payload of the first netlink attribute of the given
+// type
+func diagAttr(attrs []byte, typ uint16) []byte {
+	ne := binary.NativeEndian
+	for len(attrs) >= syscall.SizeofRtAttr {
+		l := int(ne.Uint16(attrs[0:]))
+		if l < syscall.SizeofRtAttr || l > len(attrs) {
+			return nil
+		}
+		if ne.Uint16(attrs[2:]) == typ {
+			return attrs[syscall.SizeofRtAttr:l]
+		}
+		next := (l + syscall.RTA_ALIGNTO - 1) &^ (syscall.RTA_ALIGNTO - 1)
+		if next > len(attrs) {
+			return nil
+		}
+		attrs = attrs[next:]
+	}
+	return nil
+}
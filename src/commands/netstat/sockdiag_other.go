@@ -0,0 +1,14 @@
+//go:build !linux
+
+package netstat
+
+import (
+	"fmt"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+)
+
+// tcpSocketBytes needs the Linux sock_diag netlink interface
+func tcpSocketBytes() (map[uint64]data.SocketBytes, error) {
+	return nil, fmt.Errorf("per-socket counters need Linux")
+}
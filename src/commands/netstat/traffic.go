@@ -0,0 +1,88 @@
+package netstat
+
+import (
+	"maps"
+	"sync"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// ownerRescanInterval limits full walks of every process's fds, done when
+// sockets turn up that neither the cache nor new processes account for
+const ownerRescanInterval = 10 * time.Second
+
+var (
+	ownersMutex  sync.Mutex
+	ownerCache   = make(map[uint64]int32) // Socket inode -> PID
+	scannedPids  = make(map[int32]bool)   // PIDs whose fds are in the cache
+	lastFullScan time.Time
+)
+
+// SocketTrafficCmd samples the byte counters of every TCP socket and the
+// process owning each one. The model turns consecutive samples into
+// per-socket and per-process rates.
+func SocketTrafficCmd() tea.Cmd {
+	return func() tea.Msg {
+		sockets, err := tcpSocketBytes()
+		if err != nil {
+			return messages.SocketTrafficMsg{Time: time.Now()}
+		}
+		return messages.SocketTrafficMsg{
+			Available: true,
+			Sockets:   sockets,
+			Owners:    cachedOwners(sockets),
+			Time:      time.Now(),
+		}
+	}
+}
+
+// cachedOwners finds the owners of the sampled sockets without walking
+// every fd of every process each time. Owners are kept between samples and
+// only processes started since the last sample are scanned. Sockets still
+// unaccounted for trigger a full walk, at most every ownerRescanInterval;
+// until then a new socket of an older process goes unattributed.
+func cachedOwners(sockets map[uint64]data.SocketBytes) map[uint64]int32 {
+	ownersMutex.Lock()
+	defer ownersMutex.Unlock()
+
+	pids := listPids()
+	running := make(map[int32]bool, len(pids))
+	for _, pid := range pids {
+		running[pid] = true
+		if !scannedPids[pid] {
+			addSocketOwners(ownerCache, pid)
+		}
+	}
+	scannedPids = running
+	for inode, pid := range ownerCache {
+		if !running[pid] {
+			// The socket may live on in a child; the next full walk finds it
+			delete(ownerCache, inode)
+		}
+	}
+
+	for inode := range sockets {
+		if _, ok := ownerCache[inode]; !ok && inode != 0 {
+			if time.Since(lastFullScan) >= ownerRescanInterval {
+				ownerCache = socketOwners()
+				lastFullScan = time.Now()
+			}
+			break
+		}
+	}
+
+	// Only the open sockets are kept, so closed ones drop out of the cache.
+	// The model keeps the sample, so it gets a copy.
+	owners := make(map[uint64]int32, len(sockets))
+	for inode := range sockets {
+		if pid, ok := ownerCache[inode]; ok {
+			owners[inode] = pid
+		}
+	}
+	ownerCache = owners
+	return maps.Clone(owners)
+}
@@ -0,0 +1,134 @@
+// Package probe measures the latency and availability of TCP and HTTP
+// endpoints. Probes use ordinary connections, so they need neither ICMP
+// nor root.
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"os"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/config"
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// RunCmd makes one attempt against a configured endpoint
+func RunCmd(cfg config.ProbeConfig) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.Timeout)*time.Millisecond)
+		defer cancel()
+
+		var result data.ProbeResult
+		switch cfg.Type {
+		case "tcp":
+			result = probeTCP(ctx, cfg.Target)
+		case "http":
+			result = probeHTTP(ctx, cfg.Target, cfg.Insecure)
+		default:
+			result = data.ProbeResult{Err: fmt.Sprintf("unknown probe type %q", cfg.Type)}
+		}
+		result.Time = time.Now()
+		return messages.ProbeResultMsg{Name: cfg.Name, Result: result}
+	}
+}
+
+// probeTCP opens and closes a connection, timing the connect
+func probeTCP(ctx context.Context, target string) data.ProbeResult {
+	var d net.Dialer
+	start := time.Now()
+	conn, err := d.DialContext(ctx, "tcp", target)
+	if err != nil {
+		return data.ProbeResult{Err: errorText(err)}
+	}
+	elapsed := time.Since(start)
+	conn.Close()
+	return data.ProbeResult{OK: true, Connect: elapsed, Total: elapsed}
+}
+
+// probeHTTP sends a GET on a fresh connection and times the connect, the
+// TLS handshake and the response headers. Redirects are not followed and
+// any status below 400 counts as success.
+func probeHTTP(ctx context.Context, url string, insecure bool) data.ProbeResult {
+	var result data.ProbeResult
+	var connectStart, tlsStart time.Time
+	trace := &httptrace.ClientTrace{
+		ConnectStart: func(_, _ string) { connectStart = time.Now() },
+		ConnectDone: func(_, _ string, err error) {
+			if err == nil {
+				result.Connect = time.Since(connectStart)
+			}
+		},
+		TLSHandshakeStart: func() { tlsStart = time.Now() },
+		TLSHandshakeDone: func(_ tls.ConnectionState, err error) {
+			if err == nil {
+				result.Handshake = time.Since(tlsStart)
+			}
+		},
+	}
+
+	req, err := http.NewRequestWithContext(httptrace.WithClientTrace(ctx, trace), http.MethodGet, url, nil)
+	if err != nil {
+		return data.ProbeResult{Err: err.Error()}
+	}
+	req.Header.Set("User-Agent", "bubbleMonitor-probe")
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			DisableKeepAlives: true,
+			TLSClientConfig:   &tls.Config{InsecureSkipVerify: insecure},
+			Proxy:             http.ProxyFromEnvironment,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		result.Err = errorText(err)
+		return result
+	}
+	result.Total = time.Since(start)
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	resp.Body.Close()
+
+	result.Status = resp.StatusCode
+	result.OK = resp.StatusCode < 400
+	if !result.OK {
+		result.Err = resp.Status
+	}
+	return result
+}
+
+// errorText shortens the common failures to what a narrow column can show
+func errorText(err error) string {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return "timeout"
+	}
+	var sysErr *os.SyscallError
+	if errors.As(err, &sysErr) {
+		return sysErr.Err.Error()
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Err != nil {
+		return opErr.Err.Error()
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err.Error()
+	}
+	return err.Error()
+}
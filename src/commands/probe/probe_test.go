@@ -0,0 +1,153 @@
+package probe
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/N1xev/bubbleMonitor/src/config"
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// run makes one attempt through RunCmd, as the model does
+func run(t *testing.T, cfg config.ProbeConfig) data.ProbeResult {
+	t.Helper()
+	if cfg.Timeout == 0 {
+		cfg.Timeout = config.DefaultProbeTimeout
+	}
+	msg, ok := RunCmd(cfg)().(messages.ProbeResultMsg)
+	if !ok {
+		t.Fatalf("RunCmd returned %T", msg)
+	}
+	if msg.Name != cfg.Name {
+		t.Errorf("result for %q, want %q", msg.Name, cfg.Name)
+	}
+	if msg.Result.Time.IsZero() {
+		t.Error("result has no time")
+	}
+	return msg.Result
+}
+
+// closedAddr returns a local address nothing listens on
+func closedAddr(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+	return addr
+}
+
+func TestTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	t.Run("success", func(t *testing.T) {
+		r := run(t, config.ProbeConfig{Name: "up", Type: "tcp", Target: ln.Addr().String()})
+		if !r.OK || r.Err != "" {
+			t.Fatalf("got OK=%v Err=%q, want success", r.OK, r.Err)
+		}
+		if r.Connect <= 0 || r.Total != r.Connect {
+			t.Errorf("connect %v, total %v", r.Connect, r.Total)
+		}
+	})
+
+	t.Run("refused", func(t *testing.T) {
+		r := run(t, config.ProbeConfig{Name: "down", Type: "tcp", Target: closedAddr(t)})
+		if r.OK || r.Err != "connection refused" {
+			t.Fatalf("got OK=%v Err=%q, want connection refused", r.OK, r.Err)
+		}
+	})
+}
+
+func TestHTTP(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/health":
+			w.Write([]byte("ok"))
+		case "/moved":
+			http.Redirect(w, r, "/elsewhere", http.StatusFound)
+		default:
+			http.Error(w, "broken", http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	t.Run("success", func(t *testing.T) {
+		r := run(t, config.ProbeConfig{Name: "up", Type: "http", Target: srv.URL + "/health"})
+		if !r.OK || r.Status != http.StatusOK {
+			t.Fatalf("got OK=%v Status=%d Err=%q, want 200", r.OK, r.Status, r.Err)
+		}
+		if r.Connect <= 0 || r.Total < r.Connect {
+			t.Errorf("connect %v, total %v", r.Connect, r.Total)
+		}
+	})
+
+	t.Run("redirect", func(t *testing.T) {
+		r := run(t, config.ProbeConfig{Name: "moved", Type: "http", Target: srv.URL + "/moved"})
+		if !r.OK || r.Status != http.StatusFound {
+			t.Fatalf("got OK=%v Status=%d, want an unfollowed 302", r.OK, r.Status)
+		}
+	})
+
+	t.Run("5xx", func(t *testing.T) {
+		r := run(t, config.ProbeConfig{Name: "broken", Type: "http", Target: srv.URL + "/broken"})
+		if r.OK || r.Status != http.StatusServiceUnavailable || r.Err != "503 Service Unavailable" {
+			t.Fatalf("got OK=%v Status=%d Err=%q, want 503", r.OK, r.Status, r.Err)
+		}
+	})
+
+	t.Run("refused", func(t *testing.T) {
+		r := run(t, config.ProbeConfig{Name: "down", Type: "http", Target: "http://" + closedAddr(t) + "/"})
+		if r.OK || r.Err != "connection refused" {
+			t.Fatalf("got OK=%v Err=%q, want connection refused", r.OK, r.Err)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		// Accepts the connection but never answers
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ln.Close()
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			<-done
+			conn.Close()
+		}()
+
+		r := run(t, config.ProbeConfig{Name: "hung", Type: "http", Target: "http://" + ln.Addr().String() + "/", Timeout: 200})
+		if r.OK || r.Err != "timeout" {
+			t.Fatalf("got OK=%v Err=%q, want timeout", r.OK, r.Err)
+		}
+	})
+}
+
+func TestUnknownType(t *testing.T) {
+	r := run(t, config.ProbeConfig{Name: "odd", Type: "icmp", Target: "127.0.0.1"})
+	if r.OK || r.Err != `unknown probe type "icmp"` {
+		t.Fatalf("got OK=%v Err=%q", r.OK, r.Err)
+	}
+}
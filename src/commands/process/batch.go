@@ -0,0 +1,81 @@
+package process
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/shirou/gopsutil/v3/process"
+
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// signalsByName maps the names offered in the signal dialog to signals.
+// All of these are defined by the syscall package on every target OS.
+var signalsByName = map[string]syscall.Signal{
+	"SIGTERM": syscall.SIGTERM,
+	"SIGINT":  syscall.SIGINT,
+	"SIGHUP":  syscall.SIGHUP,
+	"SIGQUIT": syscall.SIGQUIT,
+	"SIGKILL": syscall.SIGKILL,
+}
+
+// BatchCmd applies one action to every PID in pids and reports a summary.
+// action is one of "kill", "signal", "suspend", "resume" or "renice";
+// signal is only used by "signal" and delta only by "renice".
+func BatchCmd(action string, pids []int32, signal string, delta int) tea.Cmd {
+	return func() tea.Msg {
+		result := messages.BatchResultMsg{
+			Action: action,
+			Failed: make(map[int32]error),
+		}
+		for _, pid := range pids {
+			if err := applyAction(action, pid, signal, delta); err != nil {
+				result.Failed[pid] = err
+			} else {
+				result.Succeeded = append(result.Succeeded, pid)
+			}
+		}
+		return result
+	}
+}
+
+// applyAction performs a single batch action on one process
+func applyAction(action string, pid int32, signal string, delta int) error {
+	switch action {
+	case "kill":
+		proc, err := os.FindProcess(int(pid))
+		if err != nil {
+			return err
+		}
+		return proc.Kill()
+	case "signal":
+		sig, ok := signalsByName[signal]
+		if !ok {
+			return fmt.Errorf("unknown signal %q", signal)
+		}
+		proc, err := process.NewProcess(pid)
+		if err != nil {
+			return err
+		}
+		return proc.SendSignal(sig)
+	case "suspend":
+		proc, err := process.NewProcess(pid)
+		if err != nil {
+			return err
+		}
+		return proc.Suspend()
+	case "resume":
+		proc, err := process.NewProcess(pid)
+		if err != nil {
+			return err
+		}
+		return proc.Resume()
+	case "renice":
+		_, err := renicePid(pid, delta)
+		return err
+	default:
+		return fmt.Errorf("unknown action %q", action)
+	}
+}
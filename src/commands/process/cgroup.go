@@ -0,0 +1,35 @@
+package process
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// readCgroup returns the cgroup path of a process. On cgroup v2 this is the
+// unified hierarchy path; on v1 the systemd hierarchy is preferred.
+// Returns "" where cgroups are not available.
+func readCgroup(pid int32) string {
+	content, err := os.ReadFile(fmt.Sprintf("/proc/%d/cgroup", pid))
+	if err != nil {
+		return ""
+	}
+
+	var fallback string
+	for _, line := range strings.Split(string(content), "\n") {
+		// Format: hierarchy-ID:controller-list:cgroup-path
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		if parts[0] == "0" && parts[1] == "" {
+			return parts[2]
+		}
+		if parts[1] == "name=systemd" {
+			fallback = parts[2]
+		} else if fallback == "" {
+			fallback = parts[2]
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,278 @@
+package process
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/utils"
+)
+
+// maxCollectorWorkers bounds how many goroutines read /proc in parallel
+const maxCollectorWorkers = 8
+
+// procEntry is what the collector remembers about a PID between refreshes
+type procEntry struct {
+	StartTime uint64 // Clock ticks since boot, identifies the process owning the PID
+	Name      string
+	Cmdline   string
+	Exe       string
+	Cgroup    string
+
+	// Previous sample for rate calculation
+	LastCPUTicks uint64
+	LastIORead   uint64
+	LastIOWrite  uint64
+	LastSample   time.Time
+}
+
+var (
+	// processCache stores per-PID state from the previous refresh
+	processCache = make(map[int32]procEntry)
+	cacheMutex   sync.RWMutex
+)
+
+// procJob is a PID to sample along with its previous state
+type procJob struct {
+	pid   int32
+	prev  procEntry
+	known bool
+}
+
+// procResult is the outcome of sampling one PID
+type procResult struct {
+	info  data.ProcessInfo
+	entry procEntry
+	ok    bool
+}
+
+// collectProcesses samples every running process straight from /proc.
+// Each PID's stat, statm and status files are read once per refresh into
+// pooled buffers by a bounded set of workers; the cache lock is only held
+// to snapshot the previous samples and to store the new ones.
+func collectProcesses() ([]data.ProcessInfo, error) {
+	initProcfs()
+
+	pids, err := listPids()
+	if err != nil {
+		return nil, err
+	}
+	memTotal := readMemTotal()
+	now := time.Now()
+
+	jobs := make([]procJob, len(pids))
+	cacheMutex.RLock()
+	for i, pid := range pids {
+		prev, known := processCache[pid]
+		jobs[i] = procJob{pid: pid, prev: prev, known: known}
+	}
+	cacheMutex.RUnlock()
+
+	workers := runtime.NumCPU()
+	if workers > maxCollectorWorkers {
+		workers = maxCollectorWorkers
+	}
+	results := make([]procResult, len(jobs))
+	var next int64 = -1
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			bp := bufPool.Get().(*[]byte)
+			defer bufPool.Put(bp)
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(jobs) {
+					return
+				}
+				results[i] = sampleProcess(jobs[i], now, memTotal, bp)
+			}
+		}()
+	}
+	wg.Wait()
+
+	procList := make([]data.ProcessInfo, 0, len(results))
+	entries := make(map[int32]procEntry, len(results))
+	for _, r := range results {
+		if !r.ok {
+			continue // Exited between listing and sampling
+		}
+		entries[r.info.Pid] = r.entry
+		procList = append(procList, r.info)
+	}
+
+	// Swapping the map also drops PIDs that are no longer running
+	cacheMutex.Lock()
+	processCache = entries
+	cacheMutex.Unlock()
+
+	return procList, nil
+}
+
+// sampleProcess reads one PID's /proc files and computes its rates against
+// the previous sample
+func sampleProcess(job procJob, now time.Time, memTotal uint64, bp *[]byte) procResult {
+	dir := "/proc/" + strconv.Itoa(int(job.pid))
+
+	var err error
+	*bp, err = readProcFile(dir+"/stat", *bp)
+	if err != nil {
+		return procResult{}
+	}
+	st, err := parseStat(*bp)
+	if err != nil {
+		return procResult{}
+	}
+
+	entry := job.prev
+	sameProcess := job.known && entry.StartTime == st.StartTime
+	if !sameProcess {
+		// New process, or a recycled PID: load the static details
+		entry = procEntry{
+			StartTime: st.StartTime,
+			Cmdline:   readCmdline(dir),
+			Cgroup:    readCgroup(job.pid),
+		}
+		entry.Exe, _ = os.Readlink(dir + "/exe")
+	}
+
+	info := data.ProcessInfo{
+		Pid:        job.pid,
+		Ppid:       st.Ppid,
+		Nice:       st.Nice,
+		Status:     statusName(st.State),
+		CreateTime: st.createTimeMs(),
+		Cmdline:    entry.Cmdline,
+		Exe:        entry.Exe,
+		Cgroup:     entry.Cgroup,
+	}
+
+	// status: name (unescaped, unlike stat) and owner
+	name := st.Comm
+	if *bp, err = readProcFile(dir+"/status", *bp); err == nil {
+		if v := statusValue(*bp, "Name"); len(v) > 0 {
+			name = string(v)
+		}
+		if uid := bytes.Fields(statusValue(*bp, "Uid")); len(uid) > 0 {
+			info.Username = utils.LookupUsername(string(uid[0]))
+		}
+	}
+	if entry.Name == "" {
+		entry.Name = fullName(name, entry.Cmdline)
+	}
+	info.Name = entry.Name
+
+	// statm: resident set size in pages
+	if *bp, err = readProcFile(dir+"/statm", *bp); err == nil {
+		if fields := bytes.Fields(*bp); len(fields) > 1 {
+			pages, _ := strconv.ParseUint(string(fields[1]), 10, 64)
+			info.MemoryBytes = pages * pageSize
+		}
+	}
+	if memTotal > 0 {
+		info.Memory = float64(info.MemoryBytes) / float64(memTotal) * 100
+	}
+
+	// CPU% from the jiffies consumed since the previous sample, or the
+	// lifetime average on first sight
+	ticks := st.Utime + st.Stime
+	if sameProcess && !entry.LastSample.IsZero() {
+		if elapsed := now.Sub(entry.LastSample).Seconds(); elapsed > 0 && ticks >= entry.LastCPUTicks {
+			info.Cpu = float64(ticks-entry.LastCPUTicks) / float64(clockTicks) / elapsed * 100
+		}
+	} else if lifetime := now.Sub(time.UnixMilli(info.CreateTime)).Seconds(); lifetime > 0 {
+		info.Cpu = float64(ticks) / float64(clockTicks) / lifetime * 100
+	}
+
+	// I/O rates (needs permission for other users' processes)
+	if *bp, err = readProcFile(dir+"/io", *bp); err == nil {
+		readBytes, _ := strconv.ParseUint(string(statusValue(*bp, "read_bytes")), 10, 64)
+		writeBytes, _ := strconv.ParseUint(string(statusValue(*bp, "write_bytes")), 10, 64)
+		if sameProcess && !entry.LastSample.IsZero() {
+			elapsed := now.Sub(entry.LastSample).Seconds()
+			if elapsed > 0 && readBytes >= entry.LastIORead && writeBytes >= entry.LastIOWrite {
+				info.IOReadRate = float64(readBytes-entry.LastIORead) / elapsed
+				info.IOWriteRate = float64(writeBytes-entry.LastIOWrite) / elapsed
+			}
+		}
+		entry.LastIORead = readBytes
+		entry.LastIOWrite = writeBytes
+	}
+
+	entry.LastCPUTicks = ticks
+	entry.LastSample = now
+	return procResult{info: info, entry: entry, ok: true}
+}
+
+// listPids returns the numeric entries of /proc
+func listPids() ([]int32, error) {
+	d, err := os.Open("/proc")
+	if err != nil {
+		return nil, err
+	}
+	defer d.Close()
+
+	names, err := d.Readdirnames(-1)
+	if err != nil {
+		return nil, err
+	}
+	pids := make([]int32, 0, len(names))
+	for _, name := range names {
+		if pid, err := strconv.ParseInt(name, 10, 32); err == nil {
+			pids = append(pids, int32(pid))
+		}
+	}
+	return pids, nil
+}
+
+// readMemTotal returns the total physical memory in bytes
+func readMemTotal() uint64 {
+	bp := bufPool.Get().(*[]byte)
+	defer bufPool.Put(bp)
+
+	var err error
+	if *bp, err = readProcFile("/proc/meminfo", *bp); err != nil {
+		return 0
+	}
+	// "MemTotal:       16303412 kB"
+	fields := bytes.Fields(statusValue(*bp, "MemTotal"))
+	if len(fields) == 0 {
+		return 0
+	}
+	kb, _ := strconv.ParseUint(string(fields[0]), 10, 64)
+	return kb * 1024
+}
+
+// readCmdline returns the NUL-separated arguments joined by spaces
+func readCmdline(dir string) string {
+	content, err := os.ReadFile(dir + "/cmdline")
+	if err != nil {
+		return ""
+	}
+	content = bytes.TrimRight(content, "\x00")
+	return string(bytes.ReplaceAll(content, []byte{0}, []byte{' '}))
+}
+
+// fullName recovers names the kernel truncated to 15 characters from the
+// first command line argument, as gopsutil does
+func fullName(name, cmdline string) string {
+	if len(name) < 15 || cmdline == "" {
+		return name
+	}
+	arg := cmdline
+	if i := strings.IndexByte(arg, ' '); i >= 0 {
+		arg = arg[:i]
+	}
+	if base := filepath.Base(arg); strings.HasPrefix(base, name) {
+		return base
+	}
+	return name
+}
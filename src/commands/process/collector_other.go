@@ -0,0 +1,153 @@
+//go:build !linux
+
+package process
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/process"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+)
+
+// CachedProcessInfo stores the actual process object and its static data
+type CachedProcessInfo struct {
+	Proc       *process.Process // Persistent object for accurate CPU deltas
+	Name       string
+	Username   string
+	Cmdline    string
+	CreateTime int64
+	Nice       int32
+	Ppid       int32
+	Exe        string
+	Cgroup     string
+
+	// Previous I/O sample for rate calculation
+	LastIORead  uint64
+	LastIOWrite uint64
+	LastIOTime  time.Time
+}
+
+var (
+	// processCache stores static info by PID
+	processCache = make(map[int32]CachedProcessInfo)
+	cacheMutex   sync.RWMutex
+)
+
+// collectProcesses samples every running process through gopsutil
+func collectProcesses() ([]data.ProcessInfo, error) {
+	// Use Pids() instead of Processes() -> Cheaper, returns only []int32
+	pids, err := process.Pids()
+	if err != nil {
+		return nil, err
+	}
+
+	// Pre-allocate to avoid re-sizing (Memory Optimization)
+	procList := make([]data.ProcessInfo, 0, len(pids))
+
+	// Map for quick lookup of current PIDs to clean up cache
+	currentPids := make(map[int32]bool)
+
+	cacheMutex.Lock()
+	defer cacheMutex.Unlock()
+
+	for _, pid := range pids {
+		currentPids[pid] = true
+
+		// Try to get from cache first
+		cached, exists := processCache[pid]
+
+		if !exists {
+			// Create NEW process object only once
+			newProc, err := process.NewProcess(pid)
+			if err != nil {
+				continue // Process might have died between Pids() and NewProcess()
+			}
+
+			// Fetch static data (Expensive calls on Windows)
+			name, _ := newProc.Name()
+			username, _ := newProc.Username()
+			createTime, _ := newProc.CreateTime()
+			cmdline, _ := newProc.Cmdline()
+			nice, _ := getNice(pid)
+			ppid, _ := newProc.Ppid()
+			exe, _ := newProc.Exe()
+
+			cached = CachedProcessInfo{
+				Proc:       newProc,
+				Name:       name,
+				Username:   username,
+				Cmdline:    cmdline,
+				CreateTime: createTime,
+				Nice:       int32(nice),
+				Ppid:       ppid,
+				Exe:        exe,
+				Cgroup:     readCgroup(pid),
+			}
+		}
+
+		// Always fetch dynamic data (CPU, Memory, Status) using the PERSISTENT object
+		// This allows gopsutil to calculate true CPU usage over time intervals
+		cpuPercent, _ := cached.Proc.CPUPercent()
+		memPercent, _ := cached.Proc.MemoryPercent()
+		status, _ := cached.Proc.Status()
+		memInfo, _ := cached.Proc.MemoryInfo()
+
+		var memBytes uint64
+		if memInfo != nil {
+			memBytes = memInfo.RSS
+		}
+
+		// I/O rates from the previous sample (needs permission for other users' processes)
+		var ioReadRate, ioWriteRate float64
+		if io, err := cached.Proc.IOCounters(); err == nil && io != nil {
+			now := time.Now()
+			if !cached.LastIOTime.IsZero() {
+				elapsed := now.Sub(cached.LastIOTime).Seconds()
+				if elapsed > 0 && io.ReadBytes >= cached.LastIORead && io.WriteBytes >= cached.LastIOWrite {
+					ioReadRate = float64(io.ReadBytes-cached.LastIORead) / elapsed
+					ioWriteRate = float64(io.WriteBytes-cached.LastIOWrite) / elapsed
+				}
+			}
+			cached.LastIORead = io.ReadBytes
+			cached.LastIOWrite = io.WriteBytes
+			cached.LastIOTime = now
+		}
+		processCache[pid] = cached
+
+		// Get a readable status
+		statusStr := strings.Join(status, ",")
+		if statusStr == "" {
+			statusStr = "running"
+		}
+
+		procList = append(procList, data.ProcessInfo{
+			Name:        cached.Name,
+			Pid:         pid,
+			Cpu:         cpuPercent,
+			Memory:      float64(memPercent),
+			Status:      statusStr,
+			Username:    cached.Username,
+			CreateTime:  cached.CreateTime,
+			Cmdline:     cached.Cmdline,
+			MemoryBytes: memBytes,
+			Nice:        cached.Nice,
+			Ppid:        cached.Ppid,
+			Exe:         cached.Exe,
+			Cgroup:      cached.Cgroup,
+			IOReadRate:  ioReadRate,
+			IOWriteRate: ioWriteRate,
+		})
+	}
+
+	// Clean up cache: remove PIDs that are no longer running
+	for pid := range processCache {
+		if !currentPids[pid] {
+			delete(processCache, pid)
+		}
+	}
+
+	return procList, nil
+}
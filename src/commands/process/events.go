@@ -0,0 +1,207 @@
+package process
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/shirou/gopsutil/v3/process"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// trackedProcess is what the lifecycle tracker remembers about a live PID
+type trackedProcess struct {
+	Name       string
+	Username   string
+	Cmdline    string
+	Ppid       int32
+	CreateTime int64 // Milliseconds since epoch, identifies the PID's owner
+	PeakCpu    float64
+	PeakMemory uint64
+}
+
+var (
+	// trackedProcs holds every PID seen on the previous events tick
+	trackedProcs  = make(map[int32]trackedProcess)
+	trackerPrimed bool
+	trackerMutex  sync.Mutex
+)
+
+// ProcessEventsCmd diffs the current PID set against the previous one and
+// reports processes that started or exited in between. It is cheap enough
+// to run every tick, so short-lived processes that ProcessesCmd never
+// samples still show up. Events are appended to logPath as JSON lines when
+// it is set.
+func ProcessEventsCmd(logPath string) tea.Cmd {
+	return func() tea.Msg {
+		pids, err := process.Pids()
+		if err != nil {
+			return messages.ProcessEventsMsg{}
+		}
+
+		now := time.Now()
+		var events []data.ProcessEvent
+		var reused []int32
+		current := make(map[int32]bool, len(pids))
+
+		trackerMutex.Lock()
+		for _, pid := range pids {
+			current[pid] = true
+			ct, err := createTime(pid)
+			if err != nil {
+				continue // Exited while we were looking
+			}
+
+			old, known := trackedProcs[pid]
+			if known && old.CreateTime == ct {
+				continue
+			}
+
+			pidReused := known
+			if pidReused {
+				// Same PID, different process: the old one exited in between
+				events = append(events, exitEvent(pid, old, now, true))
+				reused = append(reused, pid)
+			}
+
+			tracked, ok := inspectProcess(pid, ct)
+			if !ok {
+				delete(trackedProcs, pid)
+				continue
+			}
+			trackedProcs[pid] = tracked
+			if trackerPrimed {
+				events = append(events, data.ProcessEvent{
+					Time:      now,
+					Type:      "start",
+					Pid:       pid,
+					Ppid:      tracked.Ppid,
+					Name:      tracked.Name,
+					Username:  tracked.Username,
+					Cmdline:   tracked.Cmdline,
+					PidReused: pidReused,
+				})
+			}
+		}
+
+		for pid, old := range trackedProcs {
+			if !current[pid] {
+				events = append(events, exitEvent(pid, old, now, false))
+				delete(trackedProcs, pid)
+			}
+		}
+		// Processes running at startup are not reported as starts
+		if !trackerPrimed {
+			trackerPrimed = true
+			events = nil
+		}
+		trackerMutex.Unlock()
+
+		// Make ProcessesCmd rebuild the static info of recycled PIDs
+		if len(reused) > 0 {
+			cacheMutex.Lock()
+			for _, pid := range reused {
+				delete(processCache, pid)
+			}
+			cacheMutex.Unlock()
+		}
+
+		var logErr error
+		if logPath != "" && len(events) > 0 {
+			logErr = appendEventLog(logPath, events)
+		}
+
+		return messages.ProcessEventsMsg{Events: events, Err: logErr}
+	}
+}
+
+// inspectProcess fetches the identifying details of a newly seen process
+func inspectProcess(pid int32, ct int64) (trackedProcess, bool) {
+	proc, err := process.NewProcess(pid)
+	if err != nil {
+		return trackedProcess{}, false
+	}
+	name, _ := proc.Name()
+	username, _ := proc.Username()
+	cmdline, _ := proc.Cmdline()
+	ppid, _ := proc.Ppid()
+	return trackedProcess{
+		Name:       name,
+		Username:   username,
+		Cmdline:    cmdline,
+		Ppid:       ppid,
+		CreateTime: ct,
+	}, true
+}
+
+// exitEvent builds the exit event for a tracked process
+func exitEvent(pid int32, t trackedProcess, now time.Time, pidReused bool) data.ProcessEvent {
+	var lifetime time.Duration
+	if t.CreateTime > 0 {
+		lifetime = now.Sub(time.UnixMilli(t.CreateTime))
+		if lifetime < 0 {
+			lifetime = 0
+		}
+	}
+	return data.ProcessEvent{
+		Time:       now,
+		Type:       "exit",
+		Pid:        pid,
+		Ppid:       t.Ppid,
+		Name:       t.Name,
+		Username:   t.Username,
+		Cmdline:    t.Cmdline,
+		Lifetime:   lifetime,
+		PeakCpu:    t.PeakCpu,
+		PeakMemory: t.PeakMemory,
+		PidReused:  pidReused,
+	}
+}
+
+// recordPeaks updates the peak CPU and memory of tracked processes from a
+// ProcessesCmd sample
+func recordPeaks(procs []data.ProcessInfo) {
+	trackerMutex.Lock()
+	defer trackerMutex.Unlock()
+	for _, p := range procs {
+		t, ok := trackedProcs[p.Pid]
+		if !ok {
+			continue
+		}
+		if p.Cpu > t.PeakCpu {
+			t.PeakCpu = p.Cpu
+		}
+		if p.MemoryBytes > t.PeakMemory {
+			t.PeakMemory = p.MemoryBytes
+		}
+		trackedProcs[p.Pid] = t
+	}
+}
+
+// appendEventLog appends events to a JSONL file, creating it if needed
+func appendEventLog(path string, events []data.ProcessEvent) error {
+	if strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, path[2:])
+		}
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	for _, e := range events {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
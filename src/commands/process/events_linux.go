@@ -0,0 +1,26 @@
+package process
+
+import (
+	"fmt"
+)
+
+// createTime returns the start time of a process in milliseconds since the
+// epoch. It reads /proc/<pid>/stat directly, which is much cheaper than a
+// gopsutil round trip when polling every PID each tick.
+func createTime(pid int32) (int64, error) {
+	initProcfs()
+
+	bp := bufPool.Get().(*[]byte)
+	defer bufPool.Put(bp)
+
+	var err error
+	*bp, err = readProcFile(fmt.Sprintf("/proc/%d/stat", pid), *bp)
+	if err != nil {
+		return 0, err
+	}
+	st, err := parseStat(*bp)
+	if err != nil {
+		return 0, err
+	}
+	return st.createTimeMs(), nil
+}
@@ -0,0 +1,16 @@
+//go:build !linux
+
+package process
+
+import (
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// createTime returns the start time of a process in milliseconds since the epoch
+func createTime(pid int32) (int64, error) {
+	proc, err := process.NewProcess(pid)
+	if err != nil {
+		return 0, err
+	}
+	return proc.CreateTime()
+}
@@ -0,0 +1,163 @@
+package process
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/shirou/gopsutil/v3/host"
+	"github.com/tklauser/go-sysconf"
+)
+
+var (
+	procfsOnce sync.Once
+	bootTimeMs int64
+	clockTicks int64 = 100
+	pageSize         = uint64(os.Getpagesize())
+
+	// bufPool recycles the buffers /proc files are read into
+	bufPool = sync.Pool{New: func() any {
+		buf := make([]byte, 0, 4096)
+		return &buf
+	}}
+
+	errMalformedStat = errors.New("malformed /proc stat")
+)
+
+// initProcfs reads the constants needed to interpret /proc times
+func initProcfs() {
+	procfsOnce.Do(func() {
+		if bt, err := host.BootTime(); err == nil {
+			bootTimeMs = int64(bt) * 1000
+		}
+		if tck, err := sysconf.Sysconf(sysconf.SC_CLK_TCK); err == nil && tck > 0 {
+			clockTicks = tck
+		}
+	})
+}
+
+// procStat holds the fields of /proc/<pid>/stat the collector uses
+type procStat struct {
+	Comm      string
+	State     byte
+	Ppid      int32
+	Utime     uint64 // Clock ticks
+	Stime     uint64 // Clock ticks
+	Nice      int32
+	StartTime uint64 // Clock ticks since boot
+}
+
+// createTimeMs converts the start time to milliseconds since the epoch
+func (st procStat) createTimeMs() int64 {
+	return bootTimeMs + int64(st.StartTime)*1000/clockTicks
+}
+
+// readProcFile reads a whole /proc file into buf, growing it as needed.
+// /proc files report a size of 0, so the file is read until EOF.
+func readProcFile(path string, buf []byte) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return buf, err
+	}
+	defer f.Close()
+
+	buf = buf[:0]
+	for {
+		if len(buf) == cap(buf) {
+			buf = append(buf, 0)[:len(buf)]
+		}
+		n, err := f.Read(buf[len(buf):cap(buf)])
+		buf = buf[:len(buf)+n]
+		if err == io.EOF {
+			return buf, nil
+		}
+		if err != nil {
+			return buf, err
+		}
+	}
+}
+
+// parseStat parses the contents of /proc/<pid>/stat
+func parseStat(b []byte) (procStat, error) {
+	var st procStat
+	// The command name may contain spaces and parentheses, so it ends at the last ')'
+	start := bytes.IndexByte(b, '(')
+	end := bytes.LastIndexByte(b, ')')
+	if start < 0 || end < start {
+		return st, errMalformedStat
+	}
+	st.Comm = string(b[start+1 : end])
+
+	// Fields after the name start at field 3 (state)
+	var fields [20][]byte
+	n := 0
+	rest := b[end+1:]
+	for n < len(fields) {
+		rest = bytes.TrimLeft(rest, " ")
+		if len(rest) == 0 {
+			break
+		}
+		i := bytes.IndexByte(rest, ' ')
+		if i < 0 {
+			i = len(rest)
+		}
+		fields[n] = rest[:i]
+		rest = rest[i:]
+		n++
+	}
+	if n < len(fields) || len(fields[0]) == 0 {
+		return st, errMalformedStat
+	}
+
+	st.State = fields[0][0]
+	ppid, _ := strconv.ParseInt(string(fields[1]), 10, 32)
+	st.Ppid = int32(ppid)
+	st.Utime, _ = strconv.ParseUint(string(fields[11]), 10, 64)
+	st.Stime, _ = strconv.ParseUint(string(fields[12]), 10, 64)
+	nice, _ := strconv.ParseInt(string(fields[16]), 10, 32)
+	st.Nice = int32(nice)
+	st.StartTime, _ = strconv.ParseUint(string(fields[19]), 10, 64)
+	return st, nil
+}
+
+// statusValue returns the value of a "Key:\tvalue" line in /proc/<pid>/status
+func statusValue(b []byte, key string) []byte {
+	for len(b) > 0 {
+		line := b
+		if i := bytes.IndexByte(b, '\n'); i >= 0 {
+			line, b = b[:i], b[i+1:]
+		} else {
+			b = nil
+		}
+		if len(line) > len(key) && line[len(key)] == ':' && string(line[:len(key)]) == key {
+			return bytes.TrimSpace(line[len(key)+1:])
+		}
+	}
+	return nil
+}
+
+// statusName maps a /proc state letter to the status strings gopsutil reports
+func statusName(state byte) string {
+	switch state {
+	case 'R':
+		return "running"
+	case 'S':
+		return "sleep"
+	case 'D':
+		return "blocked"
+	case 'T', 't':
+		return "stop"
+	case 'Z':
+		return "zombie"
+	case 'I':
+		return "idle"
+	case 'W':
+		return "wait"
+	case 'L':
+		return "lock"
+	}
+	return "running"
+}
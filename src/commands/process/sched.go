@@ -0,0 +1,117 @@
+package process
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"syscall"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// errUnsupported is returned for scheduling controls the OS does not offer
+var errUnsupported = errors.New("not supported on this platform")
+
+// FetchSchedCmd reads the nice value, I/O priority and CPU affinity of a process
+func FetchSchedCmd(pid int32) tea.Cmd {
+	return func() tea.Msg {
+		nice, err := getNice(pid)
+		if err != nil {
+			return messages.SchedInfoMsg{Pid: pid, Err: err}
+		}
+		info := data.SchedInfo{Nice: nice}
+
+		if class, level, err := getIOPriority(pid); err == nil {
+			info.IOClass = class
+			info.IOLevel = level
+			info.IOSupported = true
+		}
+		if cpus, err := getAffinity(pid); err == nil {
+			info.Affinity = cpus
+			info.AffinitySupported = true
+		}
+		return messages.SchedInfoMsg{Pid: pid, Info: info}
+	}
+}
+
+// ApplySchedCmd writes the parameters that differ between orig and updated
+func ApplySchedCmd(pid int32, orig, updated data.SchedInfo) tea.Cmd {
+	return func() tea.Msg {
+		if updated.Nice != orig.Nice {
+			if err := setNice(pid, updated.Nice); err != nil {
+				return messages.PriorityChangeMsg{Pid: pid, Priority: int32(orig.Nice), Err: err}
+			}
+		}
+		if updated.IOSupported && (updated.IOClass != orig.IOClass || updated.IOLevel != orig.IOLevel) {
+			if err := setIOPriority(pid, updated.IOClass, updated.IOLevel); err != nil {
+				return messages.PriorityChangeMsg{Pid: pid, Priority: int32(updated.Nice), Err: err}
+			}
+		}
+		if updated.AffinitySupported && !equalAffinity(orig.Affinity, updated.Affinity) {
+			if err := setAffinity(pid, updated.Affinity); err != nil {
+				return messages.PriorityChangeMsg{Pid: pid, Priority: int32(updated.Nice), Err: err}
+			}
+		}
+		return messages.PriorityChangeMsg{Pid: pid, Priority: int32(updated.Nice)}
+	}
+}
+
+// equalAffinity reports whether two CPU masks allow the same CPUs
+func equalAffinity(a, b []bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// forEachThread applies a per-thread scheduling change to every thread of
+// a process, since on Linux nice values, I/O priorities and affinities
+// belong to threads. Threads that exit meanwhile are skipped; when only
+// some threads could be changed the error says how many.
+func forEachThread(pid int32, op string, apply func(tid int) error) error {
+	tids := threadIDs(pid)
+	changed := 0
+	var firstErr error
+	for _, tid := range tids {
+		err := apply(tid)
+		switch {
+		case err == nil:
+			changed++
+		case errors.Is(err, syscall.ESRCH) && tid != int(pid):
+			// The thread exited
+		case firstErr == nil:
+			firstErr = err
+		}
+	}
+	if firstErr == nil {
+		return nil
+	}
+	if changed == 0 {
+		return schedError(op, pid, firstErr)
+	}
+	return fmt.Errorf("partially applied to %d of %d threads: %w", changed, len(tids), schedError(op, pid, firstErr))
+}
+
+// schedError turns raw errno values into messages that say what went wrong
+func schedError(op string, pid int32, err error) error {
+	switch {
+	case errors.Is(err, syscall.EPERM), errors.Is(err, syscall.EACCES):
+		if runtime.GOOS == "linux" {
+			return fmt.Errorf("permission denied: %s PID %d requires root or CAP_SYS_NICE", op, pid)
+		}
+		return fmt.Errorf("permission denied: cannot %s PID %d", op, pid)
+	case errors.Is(err, syscall.ESRCH):
+		return fmt.Errorf("PID %d no longer exists", pid)
+	case errors.Is(err, syscall.EINVAL):
+		return fmt.Errorf("invalid value: cannot %s PID %d", op, pid)
+	}
+	return fmt.Errorf("%s PID %d: %w", op, pid, err)
+}
@@ -0,0 +1,108 @@
+//go:build linux
+
+package process
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"golang.org/x/sys/unix"
+)
+
+// ioprio_get/ioprio_set encoding, see linux/ioprio.h
+const (
+	ioprioClassShift = 13
+	ioprioWhoProcess = 1
+)
+
+// getIOPriority returns the I/O scheduling class and level of a process
+func getIOPriority(pid int32) (int, int, error) {
+	r, _, errno := unix.Syscall(unix.SYS_IOPRIO_GET, ioprioWhoProcess, uintptr(pid), 0)
+	if errno != 0 {
+		return 0, 0, schedError("read I/O priority of", pid, errno)
+	}
+	class := int(r) >> ioprioClassShift
+	level := int(r) & ((1 << ioprioClassShift) - 1)
+	return class, level, nil
+}
+
+// setIOPriority sets the I/O scheduling class and level of every thread of a process
+func setIOPriority(pid int32, class, level int) error {
+	if level < 0 {
+		level = 0
+	}
+	if level > 7 {
+		level = 7
+	}
+	prio := class<<ioprioClassShift | level
+	if class == 0 {
+		prio = 0
+	}
+	return forEachThread(pid, "change I/O priority of", func(tid int) error {
+		if _, _, errno := unix.Syscall(unix.SYS_IOPRIO_SET, ioprioWhoProcess, uintptr(tid), uintptr(prio)); errno != 0 {
+			return errno
+		}
+		return nil
+	})
+}
+
+// getAffinity returns the CPUs a process may run on
+func getAffinity(pid int32) ([]bool, error) {
+	var set unix.CPUSet
+	if err := unix.SchedGetaffinity(int(pid), &set); err != nil {
+		return nil, schedError("read CPU affinity of", pid, err)
+	}
+	n := numCPU()
+	cpus := make([]bool, n)
+	for i := 0; i < n; i++ {
+		cpus[i] = set.IsSet(i)
+	}
+	return cpus, nil
+}
+
+// setAffinity restricts every thread of a process to the given CPUs
+func setAffinity(pid int32, cpus []bool) error {
+	var set unix.CPUSet
+	for i, allowed := range cpus {
+		if allowed {
+			set.Set(i)
+		}
+	}
+	if set.Count() == 0 {
+		return schedError("clear CPU affinity of", pid, unix.EINVAL)
+	}
+	return forEachThread(pid, "change CPU affinity of", func(tid int) error {
+		return unix.SchedSetaffinity(tid, &set)
+	})
+}
+
+// threadIDs lists the threads of a process from /proc/<pid>/task. When it
+// cannot be read the process is treated as a single thread.
+func threadIDs(pid int32) []int {
+	entries, err := os.ReadDir(fmt.Sprintf("/proc/%d/task", pid))
+	if err != nil {
+		return []int{int(pid)}
+	}
+	tids := make([]int, 0, len(entries))
+	for _, entry := range entries {
+		if tid, err := strconv.Atoi(entry.Name()); err == nil {
+			tids = append(tids, tid)
+		}
+	}
+	if len(tids) == 0 {
+		return []int{int(pid)}
+	}
+	return tids
+}
+
+// numCPU returns the number of logical CPUs in the system. runtime.NumCPU
+// is only a fallback because it honours our own affinity mask.
+func numCPU() int {
+	if n, err := cpu.Counts(true); err == nil && n > 0 {
+		return n
+	}
+	return runtime.NumCPU()
+}
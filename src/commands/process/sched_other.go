@@ -0,0 +1,29 @@
+//go:build !linux
+
+package process
+
+// getIOPriority is only implemented on Linux
+func getIOPriority(pid int32) (int, int, error) {
+	return 0, 0, errUnsupported
+}
+
+// setIOPriority is only implemented on Linux
+func setIOPriority(pid int32, class, level int) error {
+	return errUnsupported
+}
+
+// getAffinity is only implemented on Linux
+func getAffinity(pid int32) ([]bool, error) {
+	return nil, errUnsupported
+}
+
+// setAffinity is only implemented on Linux
+func setAffinity(pid int32, cpus []bool) error {
+	return errUnsupported
+}
+
+// threadIDs returns just the process itself: outside Linux the scheduling
+// calls used here apply to the whole process
+func threadIDs(pid int32) []int {
+	return []int{int(pid)}
+}
@@ -0,0 +1,44 @@
+//go:build unix
+
+package process
+
+import (
+	"runtime"
+	"syscall"
+)
+
+// getNice returns the nice value of a process (-20 to 19)
+func getNice(pid int32) (int, error) {
+	prio, err := syscall.Getpriority(syscall.PRIO_PROCESS, int(pid))
+	if err != nil {
+		return 0, schedError("read priority of", pid, err)
+	}
+	// The raw Linux syscall returns 20-nice so that it is never negative
+	if runtime.GOOS == "linux" || runtime.GOOS == "android" {
+		return 20 - prio, nil
+	}
+	return prio, nil
+}
+
+// setNice sets the nice value of every thread of a process
+func setNice(pid int32, nice int) error {
+	return forEachThread(pid, "change priority of", func(tid int) error {
+		return syscall.Setpriority(syscall.PRIO_PROCESS, tid, clampNice(nice))
+	})
+}
+
+// nextNice returns the nice value delta steps away from current
+func nextNice(current, delta int) int {
+	return clampNice(current + delta)
+}
+
+// clampNice keeps a nice value inside the range accepted by setpriority
+func clampNice(nice int) int {
+	if nice < -20 {
+		return -20
+	}
+	if nice > 19 {
+		return 19
+	}
+	return nice
+}
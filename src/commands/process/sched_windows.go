@@ -0,0 +1,91 @@
+//go:build windows
+
+package process
+
+import (
+	"golang.org/x/sys/windows"
+)
+
+// Windows has priority classes instead of nice values. Each class is mapped
+// to a representative nice value so the rest of the app can treat them alike.
+var priorityClasses = []struct {
+	class uint32
+	nice  int
+}{
+	{windows.IDLE_PRIORITY_CLASS, 19},
+	{windows.BELOW_NORMAL_PRIORITY_CLASS, 10},
+	{windows.NORMAL_PRIORITY_CLASS, 0},
+	{windows.ABOVE_NORMAL_PRIORITY_CLASS, -5},
+	{windows.HIGH_PRIORITY_CLASS, -10},
+	{windows.REALTIME_PRIORITY_CLASS, -20},
+}
+
+// getNice returns the nice value that represents the process priority class
+func getNice(pid int32) (int, error) {
+	h, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, uint32(pid))
+	if err != nil {
+		return 0, schedError("read priority of", pid, err)
+	}
+	defer windows.CloseHandle(h)
+
+	class, err := windows.GetPriorityClass(h)
+	if err != nil {
+		return 0, schedError("read priority of", pid, err)
+	}
+	for _, pc := range priorityClasses {
+		if pc.class == class {
+			return pc.nice, nil
+		}
+	}
+	return 0, nil
+}
+
+// setNice moves the process into the priority class closest to nice
+func setNice(pid int32, nice int) error {
+	h, err := windows.OpenProcess(windows.PROCESS_SET_INFORMATION, false, uint32(pid))
+	if err != nil {
+		return schedError("change priority of", pid, err)
+	}
+	defer windows.CloseHandle(h)
+
+	if err := windows.SetPriorityClass(h, priorityClasses[classIndex(nice)].class); err != nil {
+		return schedError("change priority of", pid, err)
+	}
+	return nil
+}
+
+// nextNice steps delta priority classes away from current. A negative delta
+// raises priority, matching the Unix convention.
+func nextNice(current, delta int) int {
+	idx := classIndex(current)
+	if delta < 0 {
+		idx++
+	} else if delta > 0 {
+		idx--
+	}
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(priorityClasses) {
+		idx = len(priorityClasses) - 1
+	}
+	return priorityClasses[idx].nice
+}
+
+// classIndex returns the index of the priority class closest to nice
+func classIndex(nice int) int {
+	best := 0
+	for i, pc := range priorityClasses {
+		if abs(pc.nice-nice) < abs(priorityClasses[best].nice-nice) {
+			best = i
+		}
+	}
+	return best
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"path/filepath"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/commands/cgroup"
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// JournalLines is how many journal lines are fetched for a unit
+const JournalLines = 200
+
+// Manager controls service units. Systemctl is the real implementation;
+// tests can substitute a fake.
+type Manager interface {
+	// List returns the loaded service units
+	List() ([]data.Service, error)
+	// Action runs "start", "stop" or "restart" on a unit
+	Action(unit, action string) error
+	// Journal returns the last n journal lines of a unit, oldest first
+	Journal(unit string, n int) ([]string, error)
+}
+
+// ListCmd lists services and reads CPU and memory usage from their cgroups
+func ListCmd(mgr Manager) tea.Cmd {
+	return func() tea.Msg {
+		list, err := mgr.List()
+		if err != nil {
+			return messages.ServicesMsg{Err: err}
+		}
+		for i := range list {
+			svc := &list[i]
+			svc.Time = time.Now()
+			if svc.Cgroup == "" {
+				continue
+			}
+			dir := filepath.Join(cgroup.Root, svc.Cgroup)
+			if cpu, err := cgroup.ReadKeyedFile(filepath.Join(dir, "cpu.stat")); err == nil {
+				svc.CPUUsageUsec = cpu["usage_usec"]
+			}
+			svc.MemoryCurrent, _ = cgroup.ReadUint(filepath.Join(dir, "memory.current"))
+		}
+		return messages.ServicesMsg{Services: list}
+	}
+}
+
+// ActionCmd starts, stops or restarts a unit
+func ActionCmd(mgr Manager, unit, action string) tea.Cmd {
+	return func() tea.Msg {
+		return messages.ServiceActionMsg{Unit: unit, Action: action, Err: mgr.Action(unit, action)}
+	}
+}
+
+// JournalCmd fetches the tail of a unit's journal
+func JournalCmd(mgr Manager, unit string) tea.Cmd {
+	return func() tea.Msg {
+		lines, err := mgr.Journal(unit, JournalLines)
+		return messages.JournalMsg{Unit: unit, Lines: lines, Err: err}
+	}
+}
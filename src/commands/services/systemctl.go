@@ -0,0 +1,150 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+)
+
+// commandTimeout bounds systemctl and journalctl calls. Stopping a unit
+// can legitimately take a while.
+const commandTimeout = 30 * time.Second
+
+// showProperties are the unit properties read by List
+const showProperties = "Id,Description,LoadState,ActiveState,SubState,MainPID,NRestarts,ControlGroup"
+
+// Systemctl manages units by running systemctl and journalctl
+type Systemctl struct{}
+
+// NewSystemctl returns a Manager backed by the systemctl and journalctl commands
+func NewSystemctl() Systemctl {
+	return Systemctl{}
+}
+
+// List returns every loaded service unit, active or not
+func (Systemctl) List() ([]data.Service, error) {
+	out, err := run("systemctl", "list-units", "--type=service", "--all", "--no-legend", "--plain", "--no-pager")
+	if err != nil {
+		return nil, err
+	}
+
+	var units []string
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		// Failed units are prefixed with a bullet on some versions
+		if len(fields) > 0 && fields[0] == "●" {
+			fields = fields[1:]
+		}
+		if len(fields) < 2 || fields[1] == "not-found" {
+			continue
+		}
+		units = append(units, fields[0])
+	}
+	if len(units) == 0 {
+		return []data.Service{}, nil
+	}
+
+	out, err = run("systemctl", append([]string{"show", "--no-pager", "--property=" + showProperties}, units...)...)
+	if err != nil {
+		return nil, err
+	}
+	return parseShow(string(out)), nil
+}
+
+// parseShow parses "systemctl show" output: Key=Value lines, one blank-line
+// separated block per unit
+func parseShow(out string) []data.Service {
+	services := []data.Service{}
+	var svc data.Service
+	flush := func() {
+		if svc.Unit != "" {
+			services = append(services, svc)
+		}
+		svc = data.Service{}
+	}
+
+	for _, line := range strings.Split(out, "\n") {
+		if line == "" {
+			flush()
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "Id":
+			svc.Unit = value
+		case "Description":
+			svc.Description = value
+		case "LoadState":
+			svc.LoadState = value
+		case "ActiveState":
+			svc.ActiveState = value
+		case "SubState":
+			svc.SubState = value
+		case "MainPID":
+			pid, _ := strconv.ParseInt(value, 10, 32)
+			svc.MainPid = int32(pid)
+		case "NRestarts":
+			svc.NRestarts, _ = strconv.Atoi(value)
+		case "ControlGroup":
+			svc.Cgroup = value
+		}
+	}
+	flush()
+	return services
+}
+
+// Action runs systemctl start, stop or restart on a unit
+func (Systemctl) Action(unit, action string) error {
+	switch action {
+	case "start", "stop", "restart":
+	default:
+		return fmt.Errorf("unknown action %q", action)
+	}
+	_, err := run("systemctl", action, "--no-ask-password", unit)
+	return err
+}
+
+// Journal returns the last n lines logged by a unit
+func (Systemctl) Journal(unit string, n int) ([]string, error) {
+	out, err := run("journalctl", "--unit="+unit, "--lines="+strconv.Itoa(n), "--no-pager", "--output=short-iso")
+	if err != nil {
+		return nil, err
+	}
+	text := strings.TrimRight(string(out), "\n")
+	if text == "" {
+		return nil, nil
+	}
+	return strings.Split(text, "\n"), nil
+}
+
+// run executes a command, turning a failure into an error carrying its stderr
+func run(name string, args ...string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return nil, fmt.Errorf("%s not found; systemd is required for services", name)
+		}
+		// The first line says what went wrong; the rest points at the logs
+		if msg, _, _ := strings.Cut(strings.TrimSpace(stderr.String()), "\n"); msg != "" {
+			return nil, errors.New(msg)
+		}
+		return nil, err
+	}
+	return out, nil
+}
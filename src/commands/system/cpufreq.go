@@ -0,0 +1,36 @@
+package system
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+)
+
+// readCoreFreqs reads the current and maximum clock of each core from the
+// Linux cpufreq sysfs interface. Cores without cpufreq (VMs, other
+// platforms) are left at zero.
+func readCoreFreqs(cores int) []data.CoreFreq {
+	freqs := make([]data.CoreFreq, cores)
+	for i := range freqs {
+		dir := fmt.Sprintf("/sys/devices/system/cpu/cpu%d/cpufreq/", i)
+		freqs[i].Current = readKHz(dir + "scaling_cur_freq")
+		freqs[i].Max = readKHz(dir + "cpuinfo_max_freq")
+	}
+	return freqs
+}
+
+// readKHz reads a cpufreq value in kHz and returns it in MHz
+func readKHz(path string) float64 {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0
+	}
+	khz, err := strconv.ParseFloat(strings.TrimSpace(string(content)), 64)
+	if err != nil {
+		return 0
+	}
+	return khz / 1000
+}
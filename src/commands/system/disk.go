@@ -1,43 +1,177 @@
 package system
 
 import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
 	tea "charm.land/bubbletea/v2"
 	"github.com/shirou/gopsutil/v3/disk"
 
+	"github.com/N1xev/bubbleMonitor/src/config"
 	"github.com/N1xev/bubbleMonitor/src/data"
 	"github.com/N1xev/bubbleMonitor/src/messages"
 )
 
-// DiskInfoCmd fetches disk partition information
-func DiskInfoCmd() tea.Cmd {
+// usageTimeout bounds each filesystem stat. On a hard NFS mount whose
+// server is gone the stat blocks indefinitely.
+const usageTimeout = 2 * time.Second
+
+// networkFilesystems are listed along with the physical ones, so that an
+// unreachable server is flagged rather than its mounts vanishing
+var networkFilesystems = map[string]bool{
+	"nfs": true, "nfs4": true, "cifs": true, "smb3": true, "smbfs": true,
+	"9p": true, "ceph": true, "glusterfs": true, "afs": true, "fuse.sshfs": true,
+}
+
+var (
+	pendingMutex sync.Mutex
+	pendingUsage = make(map[string]bool) // Mountpoints whose stat has not returned
+)
+
+// DiskInfoCmd fetches usage, inodes and mount options of the partitions
+// the config selects, by default the physical and network filesystems.
+// Mountpoints are queried in parallel, each with a timeout; one that does
+// not answer is kept and marked hung.
+func DiskInfoCmd(cfg config.DiskConfig) tea.Cmd {
 	return func() tea.Msg {
+		mount := func(p disk.PartitionStat) string { return p.Mountpoint + "\x00" + p.Device }
+		physical := make(map[string]bool)
 		partitions, _ := disk.Partitions(false)
-		var diskList []data.DiskPartition
 		for _, p := range partitions {
-			usage, err := disk.Usage(p.Mountpoint)
-			if err != nil {
+			physical[mount(p)] = true
+		}
+		if all, err := disk.Partitions(true); err == nil {
+			partitions = all
+		}
+
+		diskList := []data.DiskPartition{} // Empty rather than nil once loaded
+		bound := make(map[string]int)      // Device and fstype -> index in diskList
+		seen := make(map[string]bool)
+		for _, p := range partitions {
+			// The same filesystem mounted twice on one mountpoint
+			if seen[mount(p)] {
+				continue
+			}
+			seen[mount(p)] = true
+			if !cfg.Shows(p.Mountpoint, p.Device, p.Fstype, physical[mount(p)] || networkFilesystems[p.Fstype]) {
 				continue
 			}
+			// Bind mounts share the device and report the same usage. Virtual
+			// filesystems name no device, each tmpfs is its own.
+			if cfg.GroupBindMounts && (strings.HasPrefix(p.Device, "/") || strings.Contains(p.Device, ":")) {
+				key := p.Device + "\x00" + p.Fstype
+				if i, ok := bound[key]; ok {
+					diskList[i].BindMounts = append(diskList[i].BindMounts, p.Mountpoint)
+					continue
+				}
+				bound[key] = len(diskList)
+			}
 			diskList = append(diskList, data.DiskPartition{
-				Mountpoint: p.Mountpoint,
-				Device:     p.Device,
-				Fstype:     p.Fstype,
-				Total:      usage.Total,
-				Used:       usage.Used,
-				UsedPct:    usage.UsedPercent,
+				Mountpoint:  p.Mountpoint,
+				Device:      p.Device,
+				BlockDevice: blockDevice(p.Device),
+				Fstype:      p.Fstype,
+				Options:     p.Opts,
 			})
 		}
+
+		var wg sync.WaitGroup
+		for i := range diskList {
+			wg.Add(1)
+			go func(part *data.DiskPartition) {
+				defer wg.Done()
+				readUsage(part)
+			}(&diskList[i])
+		}
+		wg.Wait()
+
+		sortPartitions(diskList, cfg.SortBy)
 		return messages.DiskInfoMsg(diskList)
 	}
 }
 
-// DiskIOCmd fetches disk I/O statistics
+// sortPartitions orders partitions by name, by usage or by size, largest
+// first. Any other mode keeps the mount order.
+func sortPartitions(parts []data.DiskPartition, by string) {
+	switch by {
+	case "name":
+		sort.SliceStable(parts, func(i, j int) bool { return parts[i].Mountpoint < parts[j].Mountpoint })
+	case "usage":
+		sort.SliceStable(parts, func(i, j int) bool { return parts[i].UsedPct > parts[j].UsedPct })
+	case "size":
+		sort.SliceStable(parts, func(i, j int) bool { return parts[i].Total > parts[j].Total })
+	}
+}
+
+// readUsage fills in a partition's space and inode usage, giving up after
+// usageTimeout. The stat goes on in the background; until it returns, later
+// calls report the mount as hung without starting another.
+func readUsage(part *data.DiskPartition) {
+	pendingMutex.Lock()
+	if pendingUsage[part.Mountpoint] {
+		pendingMutex.Unlock()
+		part.Hung = true
+		part.Err = "not responding"
+		return
+	}
+	pendingUsage[part.Mountpoint] = true
+	pendingMutex.Unlock()
+
+	type result struct {
+		usage *disk.UsageStat
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		usage, err := disk.Usage(part.Mountpoint)
+		pendingMutex.Lock()
+		delete(pendingUsage, part.Mountpoint)
+		pendingMutex.Unlock()
+		done <- result{usage, err}
+	}()
+
+	select {
+	case r := <-done:
+		if r.err != nil {
+			part.Err = r.err.Error()
+			return
+		}
+		u := r.usage
+		part.Total = u.Total
+		part.Used = u.Used
+		part.UsedPct = u.UsedPercent
+		part.Available = u.Free
+		if u.Total > u.Used+u.Free {
+			part.Reserved = u.Total - u.Used - u.Free
+		}
+		part.InodesTotal = u.InodesTotal
+		part.InodesUsed = u.InodesUsed
+		part.InodesPct = u.InodesUsedPercent
+	case <-time.After(usageTimeout):
+		part.Hung = true
+		part.Err = "not responding"
+	}
+}
+
+// DiskIOCmd fetches disk I/O statistics and picks out the whole devices,
+// whose counters already include their partitions
 func DiskIOCmd() tea.Cmd {
 	return func() tea.Msg {
 		ioCounters, err := disk.IOCounters()
 		if err != nil {
 			return messages.DiskIOMsg{}
 		}
-		return messages.DiskIOMsg(ioCounters)
+		msg := messages.DiskIOMsg{Counters: ioCounters, Time: time.Now()}
+		for name := range ioCounters {
+			if whole, stacked := wholeDevice(name); whole {
+				msg.Devices = append(msg.Devices, data.BlockDevice{Name: name, Stacked: stacked})
+			}
+		}
+		sort.Slice(msg.Devices, func(i, j int) bool {
+			return msg.Devices[i].Name < msg.Devices[j].Name
+		})
+		return msg
 	}
 }
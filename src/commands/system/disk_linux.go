@@ -0,0 +1,47 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const blockSysfs = "/sys/class/block"
+
+// blockDevice returns the whole device holding a partition's device node,
+// e.g. "nvme0n1" for /dev/nvme0n1p2 or "dm-0" for /dev/mapper/root, or ""
+// for filesystems without one (tmpfs, network mounts)
+func blockDevice(device string) string {
+	if !strings.HasPrefix(device, "/dev/") {
+		return ""
+	}
+	if resolved, err := filepath.EvalSymlinks(device); err == nil {
+		device = resolved
+	}
+	name := filepath.Base(device)
+	dir := filepath.Join(blockSysfs, name)
+	if _, err := os.Stat(filepath.Join(dir, "partition")); err == nil {
+		// The partition's sysfs directory sits inside its disk's
+		if target, err := filepath.EvalSymlinks(dir); err == nil {
+			return filepath.Base(filepath.Dir(target))
+		}
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return ""
+	}
+	return name
+}
+
+// wholeDevice reports whether a block device is a disk rather than a
+// partition, and whether it is stacked on other devices
+func wholeDevice(name string) (whole, stacked bool) {
+	dir := filepath.Join(blockSysfs, name)
+	if _, err := os.Stat(dir); err != nil {
+		return false, false
+	}
+	if _, err := os.Stat(filepath.Join(dir, "partition")); err == nil {
+		return false, false
+	}
+	slaves, _ := os.ReadDir(filepath.Join(dir, "slaves"))
+	return true, len(slaves) > 0
+}
@@ -0,0 +1,27 @@
+//go:build !linux
+
+package system
+
+import (
+	"regexp"
+	"strings"
+)
+
+// macOS names partitions after their disk: disk1s2 is on disk1
+var darwinPartition = regexp.MustCompile(`^(disk\d+)s\d+$`)
+
+// blockDevice matches a partition to the I/O counters by device name.
+// Windows reports both by drive letter.
+func blockDevice(device string) string {
+	name := strings.TrimPrefix(device, "/dev/")
+	if m := darwinPartition.FindStringSubmatch(name); m != nil {
+		return m[1]
+	}
+	return name
+}
+
+// wholeDevice treats every I/O counter as a device; outside Linux they are
+// reported per disk (macOS) or per volume (Windows), never both
+func wholeDevice(name string) (whole, stacked bool) {
+	return true, false
+}
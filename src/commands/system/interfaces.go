@@ -0,0 +1,84 @@
+package system
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+const netSysfs = "/sys/class/net"
+
+// InterfaceDetailsCmd reads each interface's addresses, flags and MTU, and
+// on Linux its operational state, link speed, duplex and driver from sysfs
+func InterfaceDetailsCmd() tea.Cmd {
+	return func() tea.Msg {
+		ifaces, err := net.Interfaces()
+		if err != nil {
+			return messages.InterfaceDetailsMsg(nil)
+		}
+
+		details := make([]data.InterfaceInfo, 0, len(ifaces))
+		for _, ifi := range ifaces {
+			info := data.InterfaceInfo{
+				Name:     ifi.Name,
+				Up:       ifi.Flags&net.FlagUp != 0,
+				Running:  ifi.Flags&net.FlagRunning != 0,
+				Loopback: ifi.Flags&net.FlagLoopback != 0,
+				MAC:      ifi.HardwareAddr.String(),
+				MTU:      ifi.MTU,
+			}
+			if addrs, err := ifi.Addrs(); err == nil {
+				for _, addr := range addrs {
+					ipNet, ok := addr.(*net.IPNet)
+					if !ok {
+						continue
+					}
+					if ipNet.IP.To4() != nil {
+						info.IPv4 = append(info.IPv4, ipNet.String())
+					} else {
+						info.IPv6 = append(info.IPv6, ipNet.String())
+					}
+				}
+			}
+			readLinkSysfs(&info)
+			details = append(details, info)
+		}
+		return messages.InterfaceDetailsMsg(details)
+	}
+}
+
+// readLinkSysfs fills in what only sysfs knows. Speed reads fail with
+// EINVAL while the link is down and on most virtual interfaces.
+func readLinkSysfs(info *data.InterfaceInfo) {
+	dir := filepath.Join(netSysfs, info.Name)
+	if _, err := os.Stat(dir); err != nil {
+		return
+	}
+	info.OperState = readString(filepath.Join(dir, "operstate"))
+	if speed, err := strconv.Atoi(readString(filepath.Join(dir, "speed"))); err == nil && speed > 0 {
+		info.Speed = speed
+	}
+	if duplex := readString(filepath.Join(dir, "duplex")); duplex != "unknown" {
+		info.Duplex = duplex
+	}
+	if driver, err := os.Readlink(filepath.Join(dir, "device", "driver")); err == nil {
+		info.Driver = filepath.Base(driver)
+	} else {
+		// No backing device: loopback, bridges, veths, tunnels, ...
+		info.Virtual = true
+	}
+	if uevent, err := os.ReadFile(filepath.Join(dir, "uevent")); err == nil {
+		for _, line := range strings.Split(string(uevent), "\n") {
+			if kind, ok := strings.CutPrefix(line, "DEVTYPE="); ok {
+				info.Kind = kind
+			}
+		}
+	}
+}
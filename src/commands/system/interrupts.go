@@ -0,0 +1,88 @@
+package system
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// InterruptsCmd reads the per-CPU hardware interrupt and softirq counters.
+// Outside Linux the result is marked unavailable.
+func InterruptsCmd() tea.Cmd {
+	return func() tea.Msg {
+		info := data.InterruptInfo{Time: time.Now()}
+
+		content, err := os.ReadFile("/proc/interrupts")
+		if err != nil {
+			return messages.InterruptsMsg(info)
+		}
+		info.Available = true
+		info.CPUs, info.Sources = parseInterrupts(string(content), false)
+
+		if content, err := os.ReadFile("/proc/softirqs"); err == nil {
+			_, softirqs := parseInterrupts(string(content), true)
+			info.Sources = append(info.Sources, softirqs...)
+		}
+		return messages.InterruptsMsg(info)
+	}
+}
+
+// parseInterrupts parses /proc/interrupts or /proc/softirqs: a header with
+// one column per online CPU, then one line per source:
+//
+//	           CPU0       CPU1
+//	 36:      24610          0  PCI-MSIX-0000:00:02.0   1-edge      virtio1-req.0
+//	NMI:          0          0   Non-maskable interrupts
+//	NET_RX:    4211        913
+//
+// Lines such as ERR: carry a single count; it is kept in the first column.
+func parseInterrupts(content string, softirq bool) (int, []data.InterruptSource) {
+	lines := strings.Split(content, "\n")
+	if len(lines) == 0 {
+		return 0, nil
+	}
+	cpus := len(strings.Fields(lines[0]))
+
+	var sources []data.InterruptSource
+	for _, line := range lines[1:] {
+		name, rest, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		src := data.InterruptSource{
+			Name:    strings.TrimSpace(name),
+			Softirq: softirq,
+			Counts:  make([]uint64, cpus),
+		}
+		fields := strings.Fields(rest)
+		n := 0
+		for n < len(fields) && n < cpus {
+			v, err := strconv.ParseUint(fields[n], 10, 64)
+			if err != nil {
+				break
+			}
+			src.Counts[n] = v
+			n++
+		}
+		if n == 0 {
+			continue
+		}
+
+		// Numbered IRQs list the chip and hardware IRQ before the devices
+		desc := fields[n:]
+		if _, err := strconv.Atoi(src.Name); err == nil && len(desc) > 2 {
+			desc = desc[2:]
+		} else if err == nil && len(desc) > 0 {
+			desc = desc[len(desc)-1:]
+		}
+		src.Device = strings.Join(desc, " ")
+		sources = append(sources, src)
+	}
+	return cpus, sources
+}
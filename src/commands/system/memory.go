@@ -0,0 +1,82 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// MemoryDetailCmd reads the Linux memory counters gopsutil does not expose:
+// zswap from /proc/meminfo, zram from sysfs and paging activity from
+// /proc/vmstat. Elsewhere the result is marked unavailable.
+func MemoryDetailCmd() tea.Cmd {
+	return func() tea.Msg {
+		detail := data.MemoryDetail{Time: time.Now()}
+
+		vmstat, err := readProcTable("/proc/vmstat", 1)
+		if err != nil {
+			return messages.MemoryDetailMsg(detail)
+		}
+		detail.Available = true
+		pageSize := uint64(os.Getpagesize())
+		detail.PageFaults = vmstat["pgfault"]
+		detail.MajorFaults = vmstat["pgmajfault"]
+		detail.SwapInBytes = vmstat["pswpin"] * pageSize
+		detail.SwapOutBytes = vmstat["pswpout"] * pageSize
+
+		// Zswap lines only exist on 5.19+ kernels built with zswap
+		if meminfo, err := readProcTable("/proc/meminfo", 1024); err == nil {
+			detail.Zswap = meminfo["Zswap:"]
+			detail.Zswapped = meminfo["Zswapped:"]
+		}
+
+		// mm_stat: orig_data_size compr_data_size mem_used_total ...
+		devices, _ := filepath.Glob("/sys/block/zram*/mm_stat")
+		for _, dev := range devices {
+			content, err := os.ReadFile(dev)
+			if err != nil {
+				continue
+			}
+			fields := strings.Fields(string(content))
+			if len(fields) < 3 {
+				continue
+			}
+			orig, _ := strconv.ParseUint(fields[0], 10, 64)
+			compr, _ := strconv.ParseUint(fields[1], 10, 64)
+			used, _ := strconv.ParseUint(fields[2], 10, 64)
+			detail.ZramDevices++
+			detail.ZramOrig += orig
+			detail.ZramCompr += compr
+			detail.ZramUsed += used
+		}
+
+		return messages.MemoryDetailMsg(detail)
+	}
+}
+
+// readProcTable parses "key value [unit]" lines such as /proc/vmstat or
+// /proc/meminfo, multiplying each value by scale
+func readProcTable(path string, scale uint64) (map[string]uint64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	table := make(map[string]uint64)
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		if v, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			table[fields[0]] = v * scale
+		}
+	}
+	return table, nil
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/shirou/gopsutil/v3/disk"
 	"github.com/shirou/gopsutil/v3/load"
 	"github.com/shirou/gopsutil/v3/mem"
-	"github.com/shirou/gopsutil/v3/net"
 
 	"github.com/N1xev/bubbleMonitor/src/messages"
 )
@@ -29,6 +28,7 @@ func FastMetricsCmd() tea.Cmd {
 			cpuVal = cpuPercent[0]
 		}
 		cpuPerCore, _ := cpu.Percent(0, true)
+		cpuTimes, _ := cpu.Times(true)
 		memInfo, _ := mem.VirtualMemory()
 		swapInfo, _ := mem.SwapMemory()
 		loadAvg, _ := load.Avg()
@@ -41,11 +41,14 @@ func FastMetricsCmd() tea.Cmd {
 			LoadAvg:    loadAvg,
 			MemInfo:    memInfo,
 			SwapInfo:   swapInfo,
+			CpuTimes:   cpuTimes,
+			CoreFreqs:  readCoreFreqs(len(cpuPerCore)),
 		}
 	}
 }
 
-// SlowMetricsCmd fetches slow-changing system metrics (Disk, Network)
+// SlowMetricsCmd fetches slow-changing system metrics (root disk usage).
+// Network rates come from the per-interface counters.
 func SlowMetricsCmd() tea.Cmd {
 	return func() tea.Msg {
 		diskInfo, _ := disk.Usage("/")
@@ -55,18 +58,7 @@ func SlowMetricsCmd() tea.Cmd {
 			diskPercent = diskInfo.UsedPercent
 		}
 
-		netIO, _ := net.IOCounters(false)
-		var netSent, netRecv uint64
-		if len(netIO) > 0 {
-			netSent = netIO[0].BytesSent
-			netRecv = netIO[0].BytesRecv
-		}
-
-		return messages.DiskNetMsg{
-			Disk:    diskPercent,
-			NetSent: netSent,
-			NetRecv: netRecv,
-		}
+		return messages.DiskNetMsg{Disk: diskPercent}
 	}
 }
 
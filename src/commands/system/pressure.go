@@ -0,0 +1,77 @@
+package system
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// PressureCmd reads system-wide Pressure Stall Information. Where PSI is
+// missing (non-Linux, kernels before 4.20) or disabled with psi=0, the
+// files cannot be read and the result is marked unavailable.
+func PressureCmd() tea.Cmd {
+	return func() tea.Msg {
+		var info data.PressureInfo
+		for _, res := range []struct {
+			file string
+			dst  *data.Pressure
+		}{
+			{"/proc/pressure/cpu", &info.CPU},
+			{"/proc/pressure/memory", &info.Memory},
+			{"/proc/pressure/io", &info.IO},
+		} {
+			content, err := os.ReadFile(res.file)
+			if err != nil {
+				continue
+			}
+			*res.dst = parsePressure(string(content))
+			info.Available = true
+		}
+		return messages.PressureMsg(info)
+	}
+}
+
+// parsePressure parses a PSI file:
+//
+//	some avg10=0.12 avg60=0.05 avg300=0.01 total=123456
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func parsePressure(content string) data.Pressure {
+	var p data.Pressure
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		var stat *data.PressureStat
+		switch fields[0] {
+		case "some":
+			stat = &p.Some
+		case "full":
+			stat = &p.Full
+		default:
+			continue
+		}
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			switch key {
+			case "avg10":
+				stat.Avg10, _ = strconv.ParseFloat(value, 64)
+			case "avg60":
+				stat.Avg60, _ = strconv.ParseFloat(value, 64)
+			case "avg300":
+				stat.Avg300, _ = strconv.ParseFloat(value, 64)
+			case "total":
+				stat.Total, _ = strconv.ParseUint(value, 10, 64)
+			}
+		}
+	}
+	return p
+}
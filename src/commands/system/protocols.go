@@ -0,0 +1,66 @@
+package system
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// ProtocolStatsCmd reads the IP, TCP and UDP counters from /proc/net/snmp
+// and the TCP extensions from /proc/net/netstat. Outside Linux the result
+// is marked unavailable.
+func ProtocolStatsCmd() tea.Cmd {
+	return func() tea.Msg {
+		stats := data.ProtocolStats{Time: time.Now(), Counters: make(map[string]uint64)}
+
+		content, err := os.ReadFile("/proc/net/snmp")
+		if err != nil {
+			return messages.ProtocolStatsMsg(stats)
+		}
+		stats.Available = true
+		parseProtocolCounters(string(content), stats.Counters)
+
+		if content, err := os.ReadFile("/proc/net/netstat"); err == nil {
+			parseProtocolCounters(string(content), stats.Counters)
+		}
+		return messages.ProtocolStatsMsg(stats)
+	}
+}
+
+// parseProtocolCounters parses /proc/net/snmp or /proc/net/netstat, where
+// each protocol has a line of names followed by a line of values:
+//
+//	Tcp: RtoAlgorithm RtoMin RtoMax MaxConn ActiveOpens ...
+//	Tcp: 1 200 120000 -1 5213 ...
+//
+// Negative values (MaxConn) are skipped.
+func parseProtocolCounters(content string, counters map[string]uint64) {
+	lines := strings.Split(content, "\n")
+	for i := 0; i+1 < len(lines); i++ {
+		proto, names, ok := strings.Cut(lines[i], ":")
+		if !ok {
+			continue
+		}
+		valueProto, values, ok := strings.Cut(lines[i+1], ":")
+		if !ok || valueProto != proto {
+			continue
+		}
+		nameFields := strings.Fields(names)
+		valueFields := strings.Fields(values)
+		for j, name := range nameFields {
+			if j >= len(valueFields) {
+				break
+			}
+			if v, err := strconv.ParseUint(valueFields[j], 10, 64); err == nil {
+				counters[proto+"."+name] = v
+			}
+		}
+		i++
+	}
+}
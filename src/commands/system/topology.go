@@ -0,0 +1,213 @@
+package system
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/shirou/gopsutil/v3/cpu"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+const (
+	cpuSysfs  = "/sys/devices/system/cpu"
+	nodeSysfs = "/sys/devices/system/node"
+)
+
+// TopologyCmd reads sockets, cores, caches and NUMA nodes from sysfs.
+// Elsewhere only the logical and physical CPU counts are filled in.
+func TopologyCmd() tea.Cmd {
+	return func() tea.Msg {
+		var topo data.CPUTopology
+		if logical, err := cpu.Counts(true); err == nil {
+			topo.Logical = logical
+		}
+		if physical, err := cpu.Counts(false); err == nil {
+			topo.Cores = physical
+		}
+
+		// Offline CPUs have no topology directory and are skipped
+		dirs, _ := filepath.Glob(filepath.Join(cpuSysfs, "cpu[0-9]*", "topology"))
+		if len(dirs) == 0 {
+			return messages.TopologyMsg(topo)
+		}
+		topo.Available = true
+
+		nodeOf := readNodeCPUs()
+		sockets := make(map[int]bool)
+		cores := make(map[[2]int]bool)
+		for _, dir := range dirs {
+			id, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(filepath.Dir(dir)), "cpu"))
+			if err != nil {
+				continue
+			}
+			pl := data.CPUPlacement{
+				CPU:    id,
+				Socket: readInt(filepath.Join(dir, "physical_package_id")),
+				Core:   readInt(filepath.Join(dir, "core_id")),
+				Node:   nodeOf[id],
+			}
+			sockets[pl.Socket] = true
+			cores[[2]int{pl.Socket, pl.Core}] = true
+			topo.CPUs = append(topo.CPUs, pl)
+		}
+		sort.Slice(topo.CPUs, func(i, j int) bool { return topo.CPUs[i].CPU < topo.CPUs[j].CPU })
+		topo.Logical = len(topo.CPUs)
+		topo.Cores = len(cores)
+		topo.Sockets = len(sockets)
+		topo.Caches = readCaches(topo.CPUs)
+		topo.Nodes = readNumaNodes()
+
+		return messages.TopologyMsg(topo)
+	}
+}
+
+// readCaches lists each cache kind once, counting the distinct instances by
+// the set of CPUs sharing them
+func readCaches(cpus []data.CPUPlacement) []data.CPUCache {
+	type kind struct {
+		level int
+		typ   string
+	}
+	caches := make(map[kind]*data.CPUCache)
+	shared := make(map[kind]map[string]bool)
+	for _, pl := range cpus {
+		dirs, _ := filepath.Glob(filepath.Join(cpuSysfs, fmt.Sprintf("cpu%d", pl.CPU), "cache", "index[0-9]*"))
+		for _, dir := range dirs {
+			k := kind{readInt(filepath.Join(dir, "level")), readString(filepath.Join(dir, "type"))}
+			if caches[k] == nil {
+				caches[k] = &data.CPUCache{Level: k.level, Type: k.typ, Size: parseCacheSize(readString(filepath.Join(dir, "size")))}
+				shared[k] = make(map[string]bool)
+			}
+			shared[k][readString(filepath.Join(dir, "shared_cpu_list"))] = true
+		}
+	}
+
+	result := make([]data.CPUCache, 0, len(caches))
+	for k, c := range caches {
+		c.Instances = len(shared[k])
+		result = append(result, *c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Level != result[j].Level {
+			return result[i].Level < result[j].Level
+		}
+		return result[i].Type < result[j].Type
+	})
+	return result
+}
+
+// readNumaNodes reads each node's CPUs, meminfo and numastat
+func readNumaNodes() []data.NumaNode {
+	dirs, _ := filepath.Glob(filepath.Join(nodeSysfs, "node[0-9]*"))
+	var nodes []data.NumaNode
+	for _, dir := range dirs {
+		id, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(dir), "node"))
+		if err != nil {
+			continue
+		}
+		node := data.NumaNode{ID: id, CPUList: readString(filepath.Join(dir, "cpulist"))}
+
+		// meminfo lines look like "Node 0 MemTotal:  5340920 kB"
+		if content, err := os.ReadFile(filepath.Join(dir, "meminfo")); err == nil {
+			for _, line := range strings.Split(string(content), "\n") {
+				fields := strings.Fields(line)
+				if len(fields) < 4 {
+					continue
+				}
+				v, _ := strconv.ParseUint(fields[3], 10, 64)
+				switch fields[2] {
+				case "MemTotal:":
+					node.MemTotal = v * 1024
+				case "MemFree:":
+					node.MemFree = v * 1024
+				case "MemUsed:":
+					node.MemUsed = v * 1024
+				}
+			}
+		}
+
+		if stat, err := readProcTable(filepath.Join(dir, "numastat"), 1); err == nil {
+			node.NumaHit = stat["numa_hit"]
+			node.NumaMiss = stat["numa_miss"]
+			node.NumaForeign = stat["numa_foreign"]
+			node.LocalNode = stat["local_node"]
+			node.OtherNode = stat["other_node"]
+		}
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+	return nodes
+}
+
+// readNodeCPUs maps each CPU to its NUMA node
+func readNodeCPUs() map[int]int {
+	nodeOf := make(map[int]int)
+	dirs, _ := filepath.Glob(filepath.Join(nodeSysfs, "node[0-9]*"))
+	for _, dir := range dirs {
+		id, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(dir), "node"))
+		if err != nil {
+			continue
+		}
+		for _, cpu := range parseCPUList(readString(filepath.Join(dir, "cpulist"))) {
+			nodeOf[cpu] = id
+		}
+	}
+	return nodeOf
+}
+
+// parseCPUList expands a sysfs CPU list such as "0-3,8,10-11"
+func parseCPUList(list string) []int {
+	var cpus []int
+	for _, part := range strings.Split(list, ",") {
+		lo, hi, isRange := strings.Cut(strings.TrimSpace(part), "-")
+		start, err := strconv.Atoi(lo)
+		if err != nil {
+			continue
+		}
+		end := start
+		if isRange {
+			if end, err = strconv.Atoi(hi); err != nil {
+				continue
+			}
+		}
+		for cpu := start; cpu <= end; cpu++ {
+			cpus = append(cpus, cpu)
+		}
+	}
+	return cpus
+}
+
+// parseCacheSize parses sysfs cache sizes such as "48K" or "32M"
+func parseCacheSize(size string) uint64 {
+	mult := uint64(1)
+	switch {
+	case strings.HasSuffix(size, "K"):
+		mult = 1024
+	case strings.HasSuffix(size, "M"):
+		mult = 1024 * 1024
+	case strings.HasSuffix(size, "G"):
+		mult = 1024 * 1024 * 1024
+	}
+	v, _ := strconv.ParseUint(strings.TrimRight(size, "KMG"), 10, 64)
+	return v * mult
+}
+
+func readString(path string) string {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(content))
+}
+
+func readInt(path string) int {
+	v, _ := strconv.Atoi(readString(path))
+	return v
+}
@@ -0,0 +1,87 @@
+package system
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// wextMaxQuality is the scale of the link quality cfg80211 reports through
+// the wireless extensions: the signal above -110 dBm, capped at 70
+const wextMaxQuality = 70
+
+// WirelessCmd reads the link of each wireless interface: quality, signal and
+// noise from /proc/net/wireless, and on Linux the SSID, signal and bitrates
+// from nl80211 where it answers
+func WirelessCmd() tea.Cmd {
+	return func() tea.Msg {
+		links := make(map[string]data.WirelessInfo)
+		if content, err := os.ReadFile("/proc/net/wireless"); err == nil {
+			parseWireless(string(content), links)
+		}
+		// cfg80211 devices without the wireless extensions compiled in
+		if phys, err := filepath.Glob(filepath.Join(netSysfs, "*", "phy80211")); err == nil {
+			for _, phy := range phys {
+				name := filepath.Base(filepath.Dir(phy))
+				if _, ok := links[name]; !ok {
+					links[name] = data.WirelessInfo{}
+				}
+			}
+		}
+		if len(links) > 0 {
+			readStations(links)
+		}
+		return messages.WirelessMsg(links)
+	}
+}
+
+// parseWireless parses /proc/net/wireless, two header lines and then one
+// line per wireless interface:
+//
+//	Inter-| sta-|   Quality        |   Discarded packets               | Missed | WE
+//	 face | tus | link level noise |  nwid  crypt   frag  retry   misc | beacon | 22
+//	 wlan0: 0000   54.  -56.  -256        0      0      0      0    140        0
+//
+// Older drivers print the level and noise as unsigned bytes; -256 (or 0)
+// means the noise is not reported.
+func parseWireless(content string, links map[string]data.WirelessInfo) {
+	lines := strings.Split(content, "\n")
+	for _, line := range lines[min(2, len(lines)):] {
+		name, rest, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(rest)
+		if len(fields) < 4 {
+			continue
+		}
+		value := func(field string) float64 {
+			v, _ := strconv.ParseFloat(strings.TrimSuffix(field, "."), 64)
+			return v
+		}
+		link, level, noise := value(fields[1]), value(fields[2]), value(fields[3])
+		if level > 0 {
+			level -= 256
+		}
+		if noise > 0 {
+			noise -= 256
+		}
+		if noise <= -256 {
+			noise = 0
+		}
+
+		info := data.WirelessInfo{Connected: link > 0}
+		if info.Connected {
+			info.Quality = min(link/wextMaxQuality*100, 100)
+			info.Signal = level
+			info.Noise = noise
+		}
+		links[strings.TrimSpace(name)] = info
+	}
+}
@@ -0,0 +1,210 @@
+package system
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"os"
+	"syscall"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+)
+
+const (
+	genlHdrLen = 4 // struct genlmsghdr
+
+	genlIDCtrl         = 0x10 // GENL_ID_CTRL
+	ctrlCmdGetFamily   = 3
+	ctrlAttrFamilyID   = 1
+	ctrlAttrFamilyName = 2
+
+	nl80211CmdGetInterface = 5
+	nl80211CmdGetStation   = 17
+	nl80211AttrIfindex     = 3
+	nl80211AttrStaInfo     = 21
+	nl80211AttrSSID        = 52
+
+	// Nested in NL80211_ATTR_STA_INFO
+	staInfoSignal    = 7 // s8, dBm
+	staInfoTxBitrate = 8
+	staInfoRxBitrate = 14
+
+	// Nested in the bitrates, in units of 100 kb/s
+	rateInfoBitrate   = 1 // u16
+	rateInfoBitrate32 = 5 // u32, newer kernels
+)
+
+// readStations adds the SSID, signal and bitrates of the access point each
+// interface is associated with, as reported by nl80211. Without nl80211
+// the /proc/net/wireless values are kept.
+func readStations(links map[string]data.WirelessInfo) {
+	c, err := openNL80211()
+	if err != nil {
+		return
+	}
+	defer syscall.Close(c.fd)
+
+	for name, info := range links {
+		ifi, err := net.InterfaceByName(name)
+		if err != nil {
+			continue
+		}
+		index := make([]byte, 4)
+		binary.NativeEndian.PutUint32(index, uint32(ifi.Index))
+		ifindex := nlAttrBytes(nl80211AttrIfindex, index)
+
+		if replies, err := c.request(c.family, nl80211CmdGetInterface, 0, ifindex); err == nil {
+			for _, reply := range replies {
+				if ssid := nlAttr(reply[genlHdrLen:], nl80211AttrSSID); ssid != nil {
+					info.SSID = string(ssid)
+				}
+			}
+		}
+
+		// A station interface lists one station: its access point
+		replies, err := c.request(c.family, nl80211CmdGetStation, syscall.NLM_F_DUMP, ifindex)
+		if err == nil && len(replies) > 0 {
+			if sta := nlAttr(replies[0][genlHdrLen:], nl80211AttrStaInfo); sta != nil {
+				info.Connected = true
+				if signal := nlAttr(sta, staInfoSignal); len(signal) >= 1 {
+					info.Signal = float64(int8(signal[0]))
+					if info.Quality == 0 {
+						info.Quality = min(max(info.Signal+110, 0)/wextMaxQuality*100, 100)
+					}
+				}
+				info.TxBitrate = bitrate(nlAttr(sta, staInfoTxBitrate))
+				info.RxBitrate = bitrate(nlAttr(sta, staInfoRxBitrate))
+			}
+		}
+		links[name] = info
+	}
+}
+
+// bitrate decodes a nested rate_info attribute into Mb/s
+func bitrate(rate []byte) float64 {
+	ne := binary.NativeEndian
+	if v := nlAttr(rate, rateInfoBitrate32); len(v) >= 4 {
+		return float64(ne.Uint32(v)) / 10
+	}
+	if v := nlAttr(rate, rateInfoBitrate); len(v) >= 2 {
+		return float64(ne.Uint16(v)) / 10
+	}
+	return 0
+}
+
+// nl80211 is a generic netlink socket with the resolved nl80211 family id
+type nl80211 struct {
+	fd     int
+	family uint16
+	seq    uint32
+}
+
+func openNL80211() (*nl80211, error) {
+	fd, err := syscall.Socket(syscall.AF_NETLINK, syscall.SOCK_DGRAM|syscall.SOCK_CLOEXEC, syscall.NETLINK_GENERIC)
+	if err != nil {
+		return nil, err
+	}
+	if err := syscall.Bind(fd, &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK}); err != nil {
+		syscall.Close(fd)
+		return nil, err
+	}
+
+	c := &nl80211{fd: fd}
+	replies, err := c.request(genlIDCtrl, ctrlCmdGetFamily, 0, nlAttrBytes(ctrlAttrFamilyName, []byte("nl80211\x00")))
+	if err == nil && len(replies) > 0 {
+		if id := nlAttr(replies[0][genlHdrLen:], ctrlAttrFamilyID); len(id) >= 2 {
+			c.family = binary.NativeEndian.Uint16(id)
+		}
+	}
+	if c.family == 0 {
+		syscall.Close(fd)
+		return nil, errors.New("nl80211 not available")
+	}
+	return c, nil
+}
+
+// request sends a generic netlink command and returns the payloads of the
+// replies, starting with the genlmsghdr. Dumps are read until the kernel
+// signals their end.
+func (c *nl80211) request(family uint16, cmd uint8, flags uint16, attrs []byte) ([][]byte, error) {
+	c.seq++
+	ne := binary.NativeEndian
+	req := make([]byte, syscall.NLMSG_HDRLEN+genlHdrLen, syscall.NLMSG_HDRLEN+genlHdrLen+len(attrs))
+	req = append(req, attrs...)
+	ne.PutUint32(req[0:], uint32(len(req)))
+	ne.PutUint16(req[4:], family)
+	ne.PutUint16(req[6:], syscall.NLM_F_REQUEST|flags)
+	ne.PutUint32(req[8:], c.seq)
+	req[syscall.NLMSG_HDRLEN] = cmd
+	req[syscall.NLMSG_HDRLEN+1] = 1 // Version
+
+	if err := syscall.Sendto(c.fd, req, 0, &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK}); err != nil {
+		return nil, err
+	}
+
+	var replies [][]byte
+	buf := make([]byte, 4*os.Getpagesize())
+	for {
+		n, _, err := syscall.Recvfrom(c.fd, buf, 0)
+		if err != nil {
+			return nil, err
+		}
+		msgs, err := syscall.ParseNetlinkMessage(buf[:n])
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range msgs {
+			if msg.Header.Seq != c.seq {
+				continue
+			}
+			switch msg.Header.Type {
+			case syscall.NLMSG_DONE:
+				return replies, nil
+			case syscall.NLMSG_ERROR:
+				if len(msg.Data) >= 4 {
+					if errno := int32(ne.Uint32(msg.Data)); errno != 0 {
+						return nil, syscall.Errno(-errno)
+					}
+				}
+				return replies, nil
+			}
+			if len(msg.Data) >= genlHdrLen {
+				replies = append(replies, msg.Data)
+			}
+		}
+		if flags&syscall.NLM_F_DUMP == 0 && len(replies) > 0 {
+			return replies, nil
+		}
+	}
+}
+
+// nlAttrBytes encodes one netlink attribute, padded to the alignment
+func nlAttrBytes(typ uint16, payload []byte) []byte {
+	l := syscall.SizeofRtAttr + len(payload)
+	attr := make([]byte, (l+syscall.RTA_ALIGNTO-1)&^(syscall.RTA_ALIGNTO-1))
+	binary.NativeEndian.PutUint16(attr[0:], uint16(l))
+	binary.NativeEndian.PutUint16(attr[2:], typ)
+	copy(attr[syscall.SizeofRtAttr:], payload)
+	return attr
+}
+
+// nlAttr returns the payload of the first netlink attribute of the given
+// type, ignoring the nested and byte order flags
+func nlAttr(attrs []byte, typ uint16) []byte {
+	ne := binary.NativeEndian
+	for len(attrs) >= syscall.SizeofRtAttr {
+		l := int(ne.Uint16(attrs[0:]))
+		if l < syscall.SizeofRtAttr || l > len(attrs) {
+			return nil
+		}
+		if ne.Uint16(attrs[2:])&0x3FFF == typ {
+			return attrs[syscall.SizeofRtAttr:l]
+		}
+		next := (l + syscall.RTA_ALIGNTO - 1) &^ (syscall.RTA_ALIGNTO - 1)
+		if next > len(attrs) {
+			return nil
+		}
+		attrs = attrs[next:]
+	}
+	return nil
+}
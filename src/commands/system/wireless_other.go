@@ -0,0 +1,8 @@
+//go:build !linux
+
+package system
+
+import "github.com/N1xev/bubbleMonitor/src/data"
+
+// readStations is only implemented on Linux, through nl80211
+func readStations(links map[string]data.WirelessInfo) {}
@@ -2,8 +2,13 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
+	"strings"
 )
 
 // MetricType defines the type of system metric
@@ -14,6 +19,20 @@ const (
 	MetricMem  MetricType = "Memory"
 	MetricDisk MetricType = "Disk"
 	MetricTemp MetricType = "Temperature"
+
+	// PSI "some avg10" thresholds, percent of time stalled
+	MetricCPUPressure MetricType = "CPU Pressure"
+	MetricMemPressure MetricType = "Memory Pressure"
+	MetricIOPressure  MetricType = "IO Pressure"
+
+	// Protocol counter thresholds, events per second
+	MetricTCPRetrans  MetricType = "TCP Retransmits"
+	MetricTCPResets   MetricType = "TCP Resets"
+	MetricListenDrops MetricType = "Listen Overflows"
+	MetricUDPErrors   MetricType = "UDP Errors"
+
+	// Wireless signal level in dBm; alerts below it
+	MetricWiFiSignal MetricType = "WiFi Signal"
 )
 
 // AppConfig holds persistent configuration
@@ -21,17 +40,157 @@ type AppConfig struct {
 	Thresholds       map[MetricType]float64 `json:"thresholds"`
 	HistoryLength    int                    `json:"history_length"`
 	ChartType        string                 `json:"chart_type"`
-	ViewType         string                 `json:"view_type"`         // "normal" or "tree"
-	SortBy           string                 `json:"sort_by"`           // "cpu", "mem", "pid"
-	Theme            string                 `json:"theme"`             // dark, light, nord, dracula, custom, etc
-	RefreshRate      int                    `json:"refresh_rate"`      // milliseconds: 500, 1000, 2000, 5000
-	BorderType       string                 `json:"border_type"`       // normal, rounded
-	BorderStyle      string                 `json:"border_style"`      // single, double, dashed
-	BackgroundOpaque bool                   `json:"background_opaque"` // true = opaque, false = transparent
+	ViewType         string                 `json:"view_type"`          // "normal" or "tree"
+	SortBy           string                 `json:"sort_by"`            // "cpu", "mem", "pid"
+	GroupBy          string                 `json:"group_by,omitempty"` // "", "name", "exe", "user", "cgroup"
+	Theme            string                 `json:"theme"`              // dark, light, nord, dracula, custom, etc
+	RefreshRate      int                    `json:"refresh_rate"`       // milliseconds: 500, 1000, 2000, 5000
+	BorderType       string                 `json:"border_type"`        // normal, rounded
+	BorderStyle      string                 `json:"border_style"`       // single, double, dashed
+	BackgroundOpaque bool                   `json:"background_opaque"`  // true = opaque, false = transparent
 	Tabs             []string               `json:"tabs,omitempty"`
 	CustomTheme      *CustomThemeConfig     `json:"custom_theme,omitempty"`
+	FilterHistory    []string               `json:"filter_history,omitempty"` // Most recent first
+	SavedFilters     map[string]string      `json:"saved_filters,omitempty"`  // Name -> filter query
+	EventLogPath     string                 `json:"event_log_path,omitempty"` // JSONL log of process start/exit events
+	DockerSocket     string                 `json:"docker_socket,omitempty"`  // Engine API socket path or http:// URL
+	NetUnits         string                 `json:"net_units,omitempty"`      // "bytes" or "bits" per second
+	NetScale         string                 `json:"net_scale,omitempty"`      // "auto" (window peak) or "link" (link speed)
+	Probes           []ProbeConfig          `json:"probes,omitempty"`
+	Disks            DiskConfig             `json:"disks"`
+}
+
+// DiskConfig selects and orders the partitions on the Disks tab
+type DiskConfig struct {
+	Include         []DiskRule `json:"include,omitempty"`           // When set, only matching mounts are listed
+	Exclude         []DiskRule `json:"exclude,omitempty"`           // Matching mounts are never listed
+	GroupBindMounts bool       `json:"group_bind_mounts,omitempty"` // One entry per device and filesystem
+	SortBy          string     `json:"sort_by,omitempty"`           // "" (mount order), "name", "usage", "size"
+}
+
+// DiskRule matches mounts by shell glob patterns; every non-empty field
+// has to match. A mountpoint pattern ending in "/**" also matches
+// everything mounted below, so "/snap/**" covers "/snap/core22/1380".
+type DiskRule struct {
+	Fstype     string `json:"fstype,omitempty"`
+	Device     string `json:"device,omitempty"`
+	Mountpoint string `json:"mountpoint,omitempty"`
+}
+
+// Match reports whether the rule matches a mount
+func (r DiskRule) Match(mountpoint, device, fstype string) bool {
+	if r.Fstype == "" && r.Device == "" && r.Mountpoint == "" {
+		return false
+	}
+	glob := func(pattern, name string) bool {
+		ok, err := path.Match(pattern, name)
+		return ok && err == nil
+	}
+	if r.Fstype != "" && !glob(r.Fstype, fstype) {
+		return false
+	}
+	if r.Device != "" && !glob(r.Device, device) {
+		return false
+	}
+	if base, ok := strings.CutSuffix(r.Mountpoint, "/**"); ok {
+		matched := base == ""
+		for dir := mountpoint; !matched && dir != "/" && dir != "."; dir = path.Dir(dir) {
+			matched = glob(base, dir)
+		}
+		if !matched {
+			return false
+		}
+	} else if r.Mountpoint != "" && !glob(r.Mountpoint, mountpoint) {
+		return false
+	}
+	return true
+}
+
+// Shows reports whether a mount is listed. Without include rules the
+// default mounts are, the physical and network filesystems; with them,
+// any mount an include rule matches. Exclude rules apply in both cases.
+func (c DiskConfig) Shows(mountpoint, device, fstype string, listedByDefault bool) bool {
+	for _, rule := range c.Exclude {
+		if rule.Match(mountpoint, device, fstype) {
+			return false
+		}
+	}
+	if len(c.Include) == 0 {
+		return listedByDefault
+	}
+	for _, rule := range c.Include {
+		if rule.Match(mountpoint, device, fstype) {
+			return true
+		}
+	}
+	return false
+}
+
+// DiskSortModes lists the partition orders in the order `S` cycles them
+func DiskSortModes() []string {
+	return []string{"", "name", "usage", "size"}
+}
+
+// ProbeConfig describes an endpoint connected to periodically to measure
+// its latency and availability
+type ProbeConfig struct {
+	Name       string  `json:"name"`                  // Defaults to the target
+	Type       string  `json:"type"`                  // "tcp" or "http"
+	Target     string  `json:"target"`                // host:port, or a URL for http
+	Interval   int     `json:"interval,omitempty"`    // Seconds between attempts, default 5
+	Timeout    int     `json:"timeout,omitempty"`     // Milliseconds, default 2000
+	Insecure   bool    `json:"insecure,omitempty"`    // Skip TLS certificate verification
+	MaxLatency float64 `json:"max_latency,omitempty"` // Alert above this many ms, 0 to disable
+	MinSuccess float64 `json:"min_success,omitempty"` // Alert below this success rate, percent
 }
 
+// Probe defaults
+const (
+	DefaultProbeInterval = 5
+	DefaultProbeTimeout  = 2000
+)
+
+// ThresholdSetting describes an alert threshold edited in the settings overlay
+type ThresholdSetting struct {
+	Metric  MetricType
+	Label   string
+	Section string // heading the row is listed under
+	Unit    string // appended to the value
+	Min     float64
+	Max     float64
+	ZeroOff bool // 0 disables the alert
+}
+
+// SettingsThresholds lists the thresholds the settings overlay edits, in
+// the order they are listed
+func SettingsThresholds() []ThresholdSetting {
+	const main, protocols = "THRESHOLDS", "PROTOCOL ALERTS (/s)"
+	unbounded := math.Inf(1)
+	return []ThresholdSetting{
+		{MetricCPU, "CPU Alert:", main, "%", 0, 100, false},
+		{MetricMem, "Mem Alert:", main, "%", 0, 100, false},
+		{MetricDisk, "Disk Alert:", main, "%", 0, 100, false},
+		{MetricTemp, "Temp Alert:", main, "°C", 0, 100, false},
+		{MetricCPUPressure, "CPU PSI:", main, "%", 0, 100, true},
+		{MetricMemPressure, "Mem PSI:", main, "%", 0, 100, true},
+		{MetricIOPressure, "IO PSI:", main, "%", 0, 100, true},
+		{MetricWiFiSignal, "WiFi Signal:", main, " dBm", -100, 0, true},
+		{MetricTCPRetrans, "Retransmits:", protocols, "", 0, unbounded, true},
+		{MetricTCPResets, "Resets:", protocols, "", 0, unbounded, true},
+		{MetricListenDrops, "Listen Drops:", protocols, "", 0, unbounded, true},
+		{MetricUDPErrors, "UDP Errors:", protocols, "", 0, unbounded, true},
+	}
+}
+
+// AllTabs returns every tab in display order. Tabs after System are
+// optional and off by default.
+func AllTabs() []string {
+	return []string{"Overview", "Metrics", "Processes", "Disks", "Network", "System", "Memory", "Containers", "Cgroups", "Services"}
+}
+
+// MaxFilterHistory limits how many filters are remembered
+const MaxFilterHistory = 20
+
 // CustomThemeConfig holds user-configurable theme colors
 type CustomThemeConfig struct {
 	Primary    string `json:"primary"`
@@ -57,12 +216,25 @@ func DefaultConfig() AppConfig {
 		BorderType:       "rounded",
 		BorderStyle:      "dashed",
 		BackgroundOpaque: true,
+		NetUnits:         "bytes",
+		NetScale:         "auto",
 		Tabs:             []string{"Overview", "Metrics", "Processes", "Disks", "Network", "System"},
 		Thresholds: map[MetricType]float64{
 			MetricCPU:  90.0,
 			MetricMem:  90.0,
 			MetricDisk: 90.0,
 			MetricTemp: 85.0,
+
+			MetricCPUPressure: 50.0,
+			MetricMemPressure: 20.0,
+			MetricIOPressure:  40.0,
+
+			MetricTCPRetrans:  50,
+			MetricTCPResets:   20,
+			MetricListenDrops: 1,
+			MetricUDPErrors:   10,
+
+			MetricWiFiSignal: -80,
 		},
 	}
 }
@@ -107,6 +279,12 @@ func LoadConfig() (AppConfig, error) {
 	if config.Thresholds == nil {
 		config.Thresholds = defaults.Thresholds
 	}
+	// Configs written before a metric existed get its default threshold
+	for metric, threshold := range defaults.Thresholds {
+		if _, ok := config.Thresholds[metric]; !ok {
+			config.Thresholds[metric] = threshold
+		}
+	}
 	// Verify history length logic if strictly needed, but 0 is valid? No, default 60.
 	if config.HistoryLength == 0 {
 		config.HistoryLength = defaults.HistoryLength
@@ -136,6 +314,46 @@ func LoadConfig() (AppConfig, error) {
 	if config.BorderStyle == "" {
 		config.BorderStyle = defaults.BorderStyle
 	}
+	// Unknown units and scales, including typos, get the defaults
+	config.NetUnits = strings.ToLower(config.NetUnits)
+	if config.NetUnits != "bytes" && config.NetUnits != "bits" {
+		config.NetUnits = defaults.NetUnits
+	}
+	config.NetScale = strings.ToLower(config.NetScale)
+	if config.NetScale != "auto" && config.NetScale != "link" {
+		config.NetScale = defaults.NetScale
+	}
+	// An unknown partition order falls back to mount order; `S` could not cycle away from it
+	config.Disks.SortBy = strings.ToLower(config.Disks.SortBy)
+	if !slices.Contains(DiskSortModes(), config.Disks.SortBy) {
+		config.Disks.SortBy = ""
+	}
+	// Probe state and alerts are keyed by name, so later duplicates get a suffix
+	probeNames := make(map[string]bool, len(config.Probes))
+	for i := range config.Probes {
+		probe := &config.Probes[i]
+		if probe.Name == "" {
+			probe.Name = probe.Target
+		}
+		name := probe.Name
+		for n := 2; probeNames[name]; n++ {
+			name = fmt.Sprintf("%s (%d)", probe.Name, n)
+		}
+		probe.Name = name
+		probeNames[name] = true
+		if probe.Type == "" {
+			probe.Type = "tcp"
+			if strings.HasPrefix(probe.Target, "http://") || strings.HasPrefix(probe.Target, "https://") {
+				probe.Type = "http"
+			}
+		}
+		if probe.Interval <= 0 {
+			probe.Interval = DefaultProbeInterval
+		}
+		if probe.Timeout <= 0 {
+			probe.Timeout = DefaultProbeTimeout
+		}
+	}
 
 	return config, nil
 }
@@ -2,6 +2,7 @@ package data
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/N1xev/bubbleMonitor/src/config"
@@ -85,6 +86,27 @@ func (am *AlertManager) CheckAlerts(s *AppState) {
 		}
 	}
 
+	// Mount Checks: filesystems that stopped answering
+	hungMetrics := make(map[config.MetricType]bool)
+	for _, part := range s.DiskPartitions {
+		if part.Hung {
+			metric := config.MetricType("Mount " + part.Mountpoint)
+			hungMetrics[metric] = true
+			if _, ok := am.ActiveAlerts[metric]; !ok {
+				am.ActiveAlerts[metric] = Alert{
+					Type:      metric,
+					Message:   fmt.Sprintf("Mount %s Not Responding (%s)", part.Mountpoint, part.Fstype),
+					Timestamp: time.Now(),
+				}
+			}
+		}
+	}
+	for metric := range am.ActiveAlerts {
+		if strings.HasPrefix(string(metric), "Mount ") && !hungMetrics[metric] {
+			delete(am.ActiveAlerts, metric)
+		}
+	}
+
 	// Temperature Check
 	tempThreshold := s.Config.Thresholds[config.MetricTemp]
 	if tempThreshold > 0 && s.CpuTemp > tempThreshold {
@@ -98,4 +120,102 @@ func (am *AlertManager) CheckAlerts(s *AppState) {
 	} else {
 		delete(am.ActiveAlerts, config.MetricTemp)
 	}
+
+	// Pressure Checks (PSI some avg10)
+	for _, psi := range []struct {
+		metric config.MetricType
+		label  string
+		value  float64
+	}{
+		{config.MetricCPUPressure, "CPU", s.Pressure.CPU.Some.Avg10},
+		{config.MetricMemPressure, "Memory", s.Pressure.Memory.Some.Avg10},
+		{config.MetricIOPressure, "I/O", s.Pressure.IO.Some.Avg10},
+	} {
+		threshold := s.Config.Thresholds[psi.metric]
+		if s.Pressure.Available && threshold > 0 && psi.value > threshold {
+			am.ActiveAlerts[psi.metric] = Alert{
+				Type:      psi.metric,
+				Value:     psi.value,
+				Threshold: threshold,
+				Message:   fmt.Sprintf("%s Pressure High: %.1f%% stalled (>%.0f%%)", psi.label, psi.value, threshold),
+				Timestamp: time.Now(),
+			}
+		} else {
+			delete(am.ActiveAlerts, psi.metric)
+		}
+	}
+
+	// Protocol Checks (events/s)
+	for _, counter := range ProtocolCounters {
+		if counter.Metric == "" {
+			continue
+		}
+		threshold := s.Config.Thresholds[counter.Metric]
+		rate, ok := s.ProtocolRates[counter.Key]
+		if ok && threshold > 0 && rate > threshold {
+			am.ActiveAlerts[counter.Metric] = Alert{
+				Type:      counter.Metric,
+				Value:     rate,
+				Threshold: threshold,
+				Message:   fmt.Sprintf("%s High: %.1f/s (>%g/s)", counter.Metric, rate, threshold),
+				Timestamp: time.Now(),
+			}
+		} else {
+			delete(am.ActiveAlerts, counter.Metric)
+		}
+	}
+
+	// Wireless Check: the weakest associated link below the threshold (dBm)
+	signalThreshold := s.Config.Thresholds[config.MetricWiFiSignal]
+	weakest, weakestName := 0.0, ""
+	for name, link := range s.Wireless {
+		if link.Connected && link.Signal < 0 && (weakestName == "" || link.Signal < weakest) {
+			weakest, weakestName = link.Signal, name
+		}
+	}
+	if signalThreshold < 0 && weakestName != "" && weakest < signalThreshold {
+		am.ActiveAlerts[config.MetricWiFiSignal] = Alert{
+			Type:      config.MetricWiFiSignal,
+			Value:     weakest,
+			Threshold: signalThreshold,
+			Message:   fmt.Sprintf("WiFi Signal Low: %s %.0f dBm (<%.0f dBm)", weakestName, weakest, signalThreshold),
+			Timestamp: time.Now(),
+		}
+	} else {
+		delete(am.ActiveAlerts, config.MetricWiFiSignal)
+	}
+
+	// Probe Checks: down, slow or flaky endpoints
+	probeMetrics := make(map[config.MetricType]bool)
+	for _, cfg := range s.Config.Probes {
+		metric := config.MetricType("Probe " + cfg.Name)
+		probeMetrics[metric] = true
+		state := s.Probes[cfg.Name]
+		if state == nil || state.Last.Time.IsZero() {
+			delete(am.ActiveAlerts, metric)
+			continue
+		}
+
+		latency := float64(state.Last.Total) / float64(time.Millisecond)
+		alert := Alert{Type: metric, Timestamp: time.Now()}
+		switch {
+		case !state.Last.OK:
+			alert.Message = fmt.Sprintf("Probe %s Down: %s", cfg.Name, state.Last.Err)
+		case cfg.MaxLatency > 0 && latency > cfg.MaxLatency:
+			alert.Value, alert.Threshold = latency, cfg.MaxLatency
+			alert.Message = fmt.Sprintf("Probe %s Slow: %.0fms (>%gms)", cfg.Name, latency, cfg.MaxLatency)
+		case cfg.MinSuccess > 0 && state.SuccessRate() < cfg.MinSuccess:
+			alert.Value, alert.Threshold = state.SuccessRate(), cfg.MinSuccess
+			alert.Message = fmt.Sprintf("Probe %s Flaky: %.0f%% succeeded (<%g%%)", cfg.Name, state.SuccessRate(), cfg.MinSuccess)
+		default:
+			delete(am.ActiveAlerts, metric)
+			continue
+		}
+		am.ActiveAlerts[metric] = alert
+	}
+	for metric := range am.ActiveAlerts {
+		if strings.HasPrefix(string(metric), "Probe ") && !probeMetrics[metric] {
+			delete(am.ActiveAlerts, metric)
+		}
+	}
 }
@@ -0,0 +1,350 @@
+package data
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FilterTerm is a single condition of a filter query,
+// e.g. `cpu>5`, `!name:kworker` or `cmd~"--config"`
+type FilterTerm struct {
+	Field  string // Empty for bare words, which match the process name
+	Op     string // ":", "=", "~", ">", "<", ">=" or "<="
+	Value  string
+	Negate bool
+
+	re    *regexp.Regexp
+	num   float64
+	bytes bool // Numeric value had a size suffix (K, M, G, T)
+}
+
+// FilterQuery is a parsed filter. A process matches when every term matches.
+type FilterQuery struct {
+	Source string
+	Terms  []FilterTerm
+}
+
+// filterFields maps field names (and aliases) to whether they are numeric
+var filterFields = map[string]bool{
+	"name":      false,
+	"user":      false,
+	"cmd":       false,
+	"exe":       false,
+	"cgroup":    false,
+	"container": false,
+	"state":     false,
+	"status":    false,
+	"pid":       true,
+	"ppid":      true,
+	"cpu":       true,
+	"mem":       true,
+	"nice":      true,
+}
+
+// stateCodes maps single-letter process states (as shown by ps) to the
+// status strings reported by gopsutil
+var stateCodes = map[string]string{
+	"r": "running",
+	"s": "sleep",
+	"d": "blocked",
+	"t": "stop",
+	"z": "zombie",
+	"i": "idle",
+	"w": "wait",
+	"l": "lock",
+}
+
+// filterOps lists operators longest first so ">=" wins over ">"
+var filterOps = []string{">=", "<=", ":", "=", "~", ">", "<"}
+
+// connFilterFields are the fields of a connection filter
+var connFilterFields = map[string]bool{
+	"proto":  false,
+	"state":  false,
+	"local":  false,
+	"remote": false,
+	"proc":   false,
+	"name":   false,
+	"user":   false,
+	"port":   true,
+	"lport":  true,
+	"rport":  true,
+	"pid":    true,
+}
+
+// ParseFilter parses a filter query such as
+// `user:postgres cpu>5 mem>2% cmd~"--config" state:Z !name:kworker`
+func ParseFilter(q string) (*FilterQuery, error) {
+	return parseQuery(q, filterFields)
+}
+
+// ParseConnFilter parses a connection filter query such as
+// `state:listen port:443 proc:nginx !proto:unix`
+func ParseConnFilter(q string) (*FilterQuery, error) {
+	return parseQuery(q, connFilterFields)
+}
+
+// parseQuery parses a query whose field names are taken from fields
+func parseQuery(q string, fields map[string]bool) (*FilterQuery, error) {
+	tokens, err := tokenizeFilter(q)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &FilterQuery{Source: q}
+	for _, tok := range tokens {
+		term, err := parseFilterTerm(tok, fields)
+		if err != nil {
+			return nil, err
+		}
+		query.Terms = append(query.Terms, term)
+	}
+	return query, nil
+}
+
+// tokenizeFilter splits a query on whitespace, keeping quoted values together
+func tokenizeFilter(q string) ([]string, error) {
+	var tokens []string
+	var cur strings.Builder
+	inQuote := false
+	for _, r := range q {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+			cur.WriteRune(r)
+		case (r == ' ' || r == '\t') && !inQuote:
+			if cur.Len() > 0 {
+				tokens = append(tokens, cur.String())
+				cur.Reset()
+			}
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	if inQuote {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+	if cur.Len() > 0 {
+		tokens = append(tokens, cur.String())
+	}
+	return tokens, nil
+}
+
+// parseFilterTerm parses a single token into a term
+func parseFilterTerm(tok string, fields map[string]bool) (FilterTerm, error) {
+	var term FilterTerm
+	if strings.HasPrefix(tok, "!") {
+		term.Negate = true
+		tok = tok[1:]
+	}
+
+	// Find the field name: leading letters followed by an operator. Field
+	// names are matched without regard to case, so CPU>50 works too.
+	i := 0
+	for i < len(tok) && (tok[i] >= 'a' && tok[i] <= 'z' || tok[i] >= 'A' && tok[i] <= 'Z') {
+		i++
+	}
+	op := ""
+	for _, o := range filterOps {
+		if strings.HasPrefix(tok[i:], o) {
+			op = o
+			break
+		}
+	}
+
+	if i == 0 || op == "" {
+		// Bare word: substring match on the name
+		term.Op = ":"
+		term.Value = unquote(tok)
+		if term.Value == "" {
+			return term, fmt.Errorf("empty term")
+		}
+		return term, nil
+	}
+
+	term.Field = strings.ToLower(tok[:i])
+	term.Op = op
+	term.Value = unquote(tok[i+len(op):])
+
+	numeric, ok := fields[term.Field]
+	if !ok {
+		return term, fmt.Errorf("unknown field %q", term.Field)
+	}
+	if term.Value == "" {
+		return term, fmt.Errorf("missing value for %s%s", term.Field, op)
+	}
+
+	switch {
+	case op == "~" && numeric:
+		return term, fmt.Errorf("%s: operator ~ needs a text field", term.Field)
+	case op == "~":
+		re, err := regexp.Compile(term.Value)
+		if err != nil {
+			return term, fmt.Errorf("invalid regex %q", term.Value)
+		}
+		term.re = re
+	case numeric:
+		num, isBytes, err := parseFilterNumber(term.Value)
+		if err != nil {
+			return term, fmt.Errorf("%s: expected a number, got %q", term.Field, term.Value)
+		}
+		term.num = num
+		term.bytes = isBytes
+		if isBytes && term.Field != "mem" {
+			return term, fmt.Errorf("%s: size suffixes only apply to mem", term.Field)
+		}
+	case op != ":" && op != "=":
+		return term, fmt.Errorf("%s: operator %s needs a numeric field", term.Field, op)
+	}
+	return term, nil
+}
+
+// parseFilterNumber parses "5", "2%", "512M" or "1.5G".
+// The boolean result reports whether the value carried a size suffix.
+func parseFilterNumber(v string) (float64, bool, error) {
+	v = strings.TrimSuffix(v, "%")
+	mult := 1.0
+	isBytes := false
+	if n := len(v); n > 0 {
+		switch strings.ToUpper(v[n-1:]) {
+		case "K":
+			mult, isBytes = 1<<10, true
+		case "M":
+			mult, isBytes = 1<<20, true
+		case "G":
+			mult, isBytes = 1<<30, true
+		case "T":
+			mult, isBytes = 1<<40, true
+		}
+		if isBytes {
+			v = v[:n-1]
+		}
+	}
+	num, err := strconv.ParseFloat(v, 64)
+	return num * mult, isBytes, err
+}
+
+// unquote strips surrounding double quotes from a value
+func unquote(v string) string {
+	if len(v) >= 2 && v[0] == '"' && v[len(v)-1] == '"' {
+		return v[1 : len(v)-1]
+	}
+	return v
+}
+
+// Match reports whether a process satisfies every term of the query
+func (f *FilterQuery) Match(p ProcessInfo) bool {
+	for i := range f.Terms {
+		if f.Terms[i].match(p) == f.Terms[i].Negate {
+			return false
+		}
+	}
+	return true
+}
+
+// match evaluates a term against a process, ignoring negation
+func (t *FilterTerm) match(p ProcessInfo) bool {
+	switch t.Field {
+	case "", "name":
+		return t.matchString(p.Name)
+	case "user":
+		return t.matchString(p.Username)
+	case "cmd":
+		return t.matchString(p.Cmdline)
+	case "exe":
+		return t.matchString(p.Exe)
+	case "cgroup":
+		return t.matchString(p.Cgroup)
+	case "container":
+		return t.matchString(p.Container) || t.matchString(p.ContainerID)
+	case "state", "status":
+		if code, ok := stateCodes[strings.ToLower(t.Value)]; ok && t.Op != "~" {
+			return strings.HasPrefix(strings.ToLower(p.Status), code)
+		}
+		return t.matchString(p.Status)
+	case "pid":
+		return t.matchNumber(float64(p.Pid))
+	case "ppid":
+		return t.matchNumber(float64(p.Ppid))
+	case "cpu":
+		return t.matchNumber(p.Cpu)
+	case "mem":
+		if t.bytes {
+			return t.matchNumber(float64(p.MemoryBytes))
+		}
+		return t.matchNumber(p.Memory)
+	case "nice":
+		return t.matchNumber(float64(p.Nice))
+	}
+	return false
+}
+
+// MatchConnection reports whether a connection satisfies every term of the
+// query. Bare words match the process name or either address.
+func (f *FilterQuery) MatchConnection(c Connection) bool {
+	for i := range f.Terms {
+		if f.Terms[i].matchConnection(c) == f.Terms[i].Negate {
+			return false
+		}
+	}
+	return true
+}
+
+// matchConnection evaluates a term against a connection, ignoring negation
+func (t *FilterTerm) matchConnection(c Connection) bool {
+	switch t.Field {
+	case "":
+		return t.matchString(c.Process) || t.matchString(c.LocalAddr) || t.matchString(c.RemoteAddr)
+	case "proto":
+		return t.matchString(c.Proto)
+	case "state":
+		return t.matchString(c.State)
+	case "local":
+		return t.matchString(c.LocalAddr)
+	case "remote":
+		return t.matchString(c.RemoteAddr)
+	case "proc", "name":
+		return t.matchString(c.Process)
+	case "user":
+		return t.matchString(c.User)
+	case "port":
+		return t.matchNumber(float64(c.LocalPort)) || t.matchNumber(float64(c.RemotePort))
+	case "lport":
+		return t.matchNumber(float64(c.LocalPort))
+	case "rport":
+		return t.matchNumber(float64(c.RemotePort))
+	case "pid":
+		return t.matchNumber(float64(c.Pid))
+	}
+	return false
+}
+
+// matchString applies a string operator
+func (t *FilterTerm) matchString(s string) bool {
+	switch t.Op {
+	case "~":
+		return t.re.MatchString(s)
+	case "=":
+		return strings.EqualFold(s, t.Value)
+	default:
+		return strings.Contains(strings.ToLower(s), strings.ToLower(t.Value))
+	}
+}
+
+// matchNumber applies a numeric operator
+func (t *FilterTerm) matchNumber(v float64) bool {
+	switch t.Op {
+	case ">":
+		return v > t.num
+	case "<":
+		return v < t.num
+	case ">=":
+		return v >= t.num
+	case "<=":
+		return v <= t.num
+	default:
+		return v == t.num
+	}
+}
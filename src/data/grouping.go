@@ -0,0 +1,160 @@
+package data
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// GroupModes lists the process grouping modes in the order `b` cycles them.
+// The empty mode shows one row per process.
+var GroupModes = []string{"", "name", "exe", "user", "cgroup", "container"}
+
+// ProcessGroup aggregates the processes that share a grouping key
+type ProcessGroup struct {
+	Key         string
+	Count       int
+	Cpu         float64
+	Memory      float64
+	MemoryBytes uint64
+	IOReadRate  float64
+	IOWriteRate float64
+	NetRxRate   float64
+	NetTxRate   float64
+	Members     []ProcessInfo
+}
+
+// ProcessGroupKey returns the key a process is grouped under for the given mode
+func ProcessGroupKey(p ProcessInfo, by string) string {
+	var key string
+	switch by {
+	case "name":
+		key = p.Name
+	case "exe":
+		key = p.Exe
+		if key == "" {
+			key = p.Name
+		}
+	case "user":
+		key = p.Username
+	case "cgroup":
+		key = p.Cgroup
+	case "container":
+		if p.Container == "" {
+			return "(host)"
+		}
+		key = p.Container
+	}
+	if key == "" {
+		return "(unknown)"
+	}
+	return key
+}
+
+// GetProcessGroups aggregates procs by the current grouping mode, ordered by
+// the current sort (pid sorting orders groups by key)
+func (s *AppState) GetProcessGroups(procs []ProcessInfo) []ProcessGroup {
+	idx := make(map[string]int)
+	var groups []ProcessGroup
+	for _, p := range procs {
+		key := ProcessGroupKey(p, s.GroupBy)
+		i, ok := idx[key]
+		if !ok {
+			i = len(groups)
+			idx[key] = i
+			groups = append(groups, ProcessGroup{Key: key})
+		}
+		g := &groups[i]
+		g.Count++
+		g.Cpu += p.Cpu
+		g.Memory += p.Memory
+		g.MemoryBytes += p.MemoryBytes
+		g.IOReadRate += p.IOReadRate
+		g.IOWriteRate += p.IOWriteRate
+		g.NetRxRate += p.NetRxRate
+		g.NetTxRate += p.NetTxRate
+		g.Members = append(g.Members, p)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		switch s.SortBy {
+		case "cpu":
+			return groups[i].Cpu > groups[j].Cpu
+		case "mem":
+			return groups[i].Memory > groups[j].Memory
+		default:
+			return groups[i].Key < groups[j].Key
+		}
+	})
+	return groups
+}
+
+// groupContainerStats returns the cgroup accounting of a container group
+func (s *AppState) groupContainerStats(g *ProcessGroup) (ContainerStats, bool) {
+	if s.GroupBy != "container" || len(g.Members) == 0 || g.Members[0].ContainerID == "" {
+		return ContainerStats{}, false
+	}
+	st, ok := s.ContainerStats[g.Members[0].ContainerID]
+	return st, ok
+}
+
+// buildGroupedList flattens the groups into rows: one aggregate row per group,
+// followed by its members when the group is expanded. Members are laid out
+// as a tree when the tree view is enabled.
+func (s *AppState) buildGroupedList(procs []ProcessInfo) ([]ProcessInfo, map[int32]int) {
+	var flatList []ProcessInfo
+	indentMap := make(map[int32]int)
+
+	for _, g := range s.GetProcessGroups(procs) {
+		group := g
+		name := group.Key
+		if s.GroupBy == "exe" || s.GroupBy == "cgroup" {
+			name = filepath.Base(strings.TrimSuffix(name, "/"))
+			if name == "" || name == "." || name == "/" {
+				name = group.Key
+			}
+		}
+		row := ProcessInfo{
+			Name:        name,
+			Cmdline:     group.Key,
+			Cpu:         group.Cpu,
+			Memory:      group.Memory,
+			MemoryBytes: group.MemoryBytes,
+			IOReadRate:  group.IOReadRate,
+			IOWriteRate: group.IOWriteRate,
+			NetRxRate:   group.NetRxRate,
+			NetTxRate:   group.NetTxRate,
+			Group:       &group,
+		}
+		if st, ok := s.groupContainerStats(&group); ok {
+			// The container's cgroup also accounts for exited children and page cache
+			row.Cpu = st.CpuPercent
+			row.MemoryBytes = st.MemoryCurrent
+			if s.MemInfo != nil && s.MemInfo.Total > 0 {
+				row.Memory = float64(st.MemoryCurrent) / float64(s.MemInfo.Total) * 100
+			}
+			row.IOReadRate = st.IOReadRate
+			row.IOWriteRate = st.IOWriteRate
+			row.ContainerID = st.ID
+		}
+		flatList = append(flatList, row)
+
+		if !s.ExpandedGroups[group.Key] {
+			continue
+		}
+		if s.TreeView {
+			members, indents := s.buildProcessTree(group.Members)
+			for _, p := range members {
+				flatList = append(flatList, p)
+				indentMap[p.Pid] = indents[p.Pid] + 1
+			}
+		} else {
+			for _, p := range group.Members {
+				flatList = append(flatList, p)
+				indentMap[p.Pid] = 1
+			}
+		}
+	}
+
+	return flatList, indentMap
+}
@@ -2,13 +2,16 @@ package data
 
 import (
 	"sort"
-	"strings"
 )
 
 // GetVisibleProcesses returns the list of processes that should be currently displayed
-// respecting filtering, tree view, and collapsed states.
+// respecting filtering, grouping, tree view, and collapsed states.
 // It returns the flat list of visible processes and a map of indentation levels.
 func (s *AppState) GetVisibleProcesses() ([]ProcessInfo, map[int32]int) {
+	if s.GroupBy != "" {
+		return s.buildGroupedList(s.GetFilteredProcesses())
+	}
+
 	if !s.TreeView {
 		// Normal view: just filtered list
 		return s.GetFilteredProcesses(), make(map[int32]int)
@@ -78,12 +81,128 @@ func (s *AppState) GetFilteredProcesses() []ProcessInfo {
 	if s.ProcessFilter == "" {
 		return s.Processes
 	}
+	query := s.ProcessQuery
+	if query == nil {
+		// Filter set without going through the editor (e.g. restored state)
+		var err error
+		if query, err = ParseFilter(s.ProcessFilter); err != nil {
+			return s.Processes
+		}
+	}
 	var filtered []ProcessInfo
-	filterLower := strings.ToLower(s.ProcessFilter)
 	for _, p := range s.Processes {
-		if strings.Contains(strings.ToLower(p.Name), filterLower) {
+		if query.Match(p) {
 			filtered = append(filtered, p)
 		}
 	}
 	return filtered
 }
+
+// SetProcessFilter updates the filter text and recompiles it. When the text
+// does not parse, the last valid query stays active and FilterError is set.
+func (s *AppState) SetProcessFilter(filter string) {
+	s.ProcessFilter = filter
+	if filter == "" {
+		s.ProcessQuery = nil
+		s.FilterError = ""
+		return
+	}
+	query, err := ParseFilter(filter)
+	if err != nil {
+		s.FilterError = err.Error()
+		return
+	}
+	s.ProcessQuery = query
+	s.FilterError = ""
+}
+
+// GetFilteredConnections returns connections matching the current filter
+func (s *AppState) GetFilteredConnections() []Connection {
+	if s.ConnQuery == nil {
+		return s.Connections
+	}
+	var filtered []Connection
+	for _, c := range s.Connections {
+		if s.ConnQuery.MatchConnection(c) {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// SetConnFilter updates the connection filter text and recompiles it,
+// keeping the last valid query when the text does not parse
+func (s *AppState) SetConnFilter(filter string) {
+	s.ConnFilter = filter
+	if filter == "" {
+		s.ConnQuery = nil
+		s.ConnFilterError = ""
+		return
+	}
+	query, err := ParseConnFilter(filter)
+	if err != nil {
+		s.ConnFilterError = err.Error()
+		return
+	}
+	s.ConnQuery = query
+	s.ConnFilterError = ""
+}
+
+// GetSubtreePids returns the PID of root followed by all of its descendants
+func (s *AppState) GetSubtreePids(root int32) []int32 {
+	children := make(map[int32][]int32)
+	for _, p := range s.Processes {
+		if p.Pid != p.Ppid {
+			children[p.Ppid] = append(children[p.Ppid], p.Pid)
+		}
+	}
+
+	var pids []int32
+	seen := make(map[int32]bool)
+	queue := []int32{root}
+	for len(queue) > 0 {
+		pid := queue[0]
+		queue = queue[1:]
+		if seen[pid] {
+			continue
+		}
+		seen[pid] = true
+		pids = append(pids, pid)
+		queue = append(queue, children[pid]...)
+	}
+	return pids
+}
+
+// GetMarkedProcesses returns the marked processes that are still running
+func (s *AppState) GetMarkedProcesses() []ProcessInfo {
+	if len(s.MarkedPids) == 0 {
+		return nil
+	}
+	var marked []ProcessInfo
+	for _, p := range s.Processes {
+		if s.MarkedPids[p.Pid] {
+			marked = append(marked, p)
+		}
+	}
+	return marked
+}
+
+// GetVisibleCgroups returns the cgroups shown in the Cgroups tab, hiding the
+// descendants of collapsed nodes
+func (s *AppState) GetVisibleCgroups() []CgroupNode {
+	var visible []CgroupNode
+	hideBelow := -1 // Depth of the collapsed ancestor, -1 when none
+	for _, node := range s.Cgroups {
+		if hideBelow >= 0 {
+			if node.Depth > hideBelow {
+				continue
+			}
+			hideBelow = -1
+		}
+		visible = append(visible, node)
+		if node.HasChildren && s.CollapsedCgroups[node.Path] {
+			hideBelow = node.Depth
+		}
+	}
+	return visible
+}
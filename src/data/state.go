@@ -26,13 +26,12 @@ type AppState struct {
 	HistoryLength  int
 	CpuHistory     *RingBuffer
 	MemHistory     *RingBuffer
-	NetHistory     *RingBuffer
+	NetRxHistory   *RingBuffer // Bytes/s over all non-loopback interfaces
+	NetTxHistory   *RingBuffer
 	SwapHistory    *RingBuffer
 	SelectedTab    int
 	Processes      []ProcessInfo
-	LastNetSent    uint64
-	LastNetRecv    uint64
-	NetSentRate    float64
+	NetSentRate    float64 // Bytes/s
 	NetRecvRate    float64
 	HostInfo       *host.InfoStat
 	DiskPartitions []DiskPartition
@@ -46,6 +45,31 @@ type AppState struct {
 	SwapInfo       *mem.SwapMemoryStat    // Cached swap info
 	CpuInfoStatic  []cpu.InfoStat         // Static CPU info
 
+	// Processor layout; CoreGrouping is "", "socket" or "node" on the Metrics tab
+	Topology     CPUTopology
+	CoreGrouping string
+
+	// Per-core time breakdown and clocks
+	CoreTimes    []CoreTimes
+	CoreFreqs    []CoreFreq
+	LastCpuTimes []cpu.TimesStat // Previous sample, for the deltas
+
+	// Metrics tab view ("" for the charts, "interrupts") and IRQ counters
+	MetricsView           string
+	Interrupts            InterruptInfo
+	InterruptScrollOffset int
+
+	// Memory breakdown (percent of total, stacked with MemHistory)
+	MemDetail         MemoryDetail
+	MemBuffersHistory *RingBuffer
+	MemCachedHistory  *RingBuffer
+
+	// Pressure Stall Information (some avg10 history per resource)
+	Pressure      PressureInfo
+	CpuPSIHistory *RingBuffer
+	MemPSIHistory *RingBuffer
+	IOPSIHistory  *RingBuffer
+
 	// Temperature
 	Sensors     []host.TemperatureStat
 	CpuTemp     float64
@@ -54,6 +78,40 @@ type AppState struct {
 	// Network
 	NetworkInterfaces     []net.IOCountersStat
 	LastNetworkInterfaces map[string]net.IOCountersStat
+	LastNetworkSample     time.Time
+	InterfaceDetails      map[string]InterfaceInfo
+	InterfaceRates        map[string]NetRate     // Bytes/s
+	InterfaceRxHistory    map[string]*RingBuffer // Bytes/s per sample
+	InterfaceTxHistory    map[string]*RingBuffer
+	Wireless              map[string]WirelessInfo
+	SignalHistory         map[string]*RingBuffer // Link quality, percent
+
+	// Network tab view ("" for the interfaces, "connections", "protocols",
+	// "probes") and sockets
+	NetworkView        string
+	Connections        []Connection
+	ConnectionsErr     string
+	ConnectionsLoading bool
+	SelectedConnection int
+	ConnScrollOffset   int
+	ConnFilter         string
+	ConnQuery          *FilterQuery // Last successfully parsed ConnFilter
+	ConnFilterError    string
+	ConnFilterMode     bool
+
+	// Per-socket and per-process TCP throughput from sock_diag
+	SocketTraffic        SocketTraffic // Last sample, for the next rates
+	SocketRates          map[uint64]NetRate
+	ProcNetRates         map[int32]NetRate
+	SocketTrafficLoading bool
+
+	// Protocol counter rates from /proc/net/snmp and /proc/net/netstat
+	ProtocolStats   ProtocolStats          // Last sample, for the next rates
+	ProtocolRates   map[string]float64     // Per second, by counter key
+	ProtocolHistory map[string]*RingBuffer // Rates of ProtocolCounters
+
+	// Endpoint probes by name, from Config.Probes
+	Probes map[string]*ProbeState
 
 	// Battery
 	Battery []*battery.Battery
@@ -61,20 +119,47 @@ type AppState struct {
 	// Disk I/O
 	DiskIO        map[string]disk.IOCountersStat
 	LastDiskIO    map[string]disk.IOCountersStat
-	DiskReadRate  float64
+	DiskReadRate  float64 // MB/s, physical devices only
 	DiskWriteRate float64
 	DiskHORead    *RingBuffer
 	DiskHOWrite   *RingBuffer
 
+	// Per-device activity, whole devices only
+	LastDiskIOTime  time.Time
+	DiskDevices     []DiskDevice
+	DiskUtilHistory map[string]*RingBuffer // Percent busy
+
 	// Process navigation and filtering
 	SelectedProcess     int
 	ProcessScrollOffset int
 	ProcessFilter       string
+	ProcessQuery        *FilterQuery // Last successfully parsed ProcessFilter
+	FilterError         string       // Parse error for ProcessFilter, if any
+	FilterHistoryIdx    int          // Position while browsing history (-1 = editing)
+	SavedFilterIdx      int          // Last recalled saved filter
 	FilterMode          bool
 	ShowKillDialog      bool
 	KillTargetPid       int32
 	KillTargetName      string
 
+	// Multi-select and batch actions
+	MarkedPids      map[int32]bool
+	ShowBatchDialog bool
+	BatchAction     string // "kill", "signal", "suspend", "resume", "renice"
+	BatchDelta      int    // Nice delta for "renice"
+	BatchSignal     string // Selected signal name for "signal"
+	BatchTargets    []ProcessInfo
+
+	// Scheduling dialog (nice, I/O priority, CPU affinity)
+	ShowSchedDialog bool
+	SchedPid        int32
+	SchedName       string
+	SchedLoading    bool
+	SchedInfo       SchedInfo // Values being edited
+	SchedOriginal   SchedInfo // Values read from the process
+	SchedField      int       // 0 nice, 1 I/O class, 2 I/O level, 3 affinity
+	SchedCpuCursor  int
+
 	// Alerts & Configuration
 	Config       config.AppConfig
 	AlertManager *AlertManager
@@ -101,10 +186,55 @@ type AppState struct {
 	OpenFilesScrollOffset int
 	OpenFilesView         SimpleViewport
 
+	// Process Lifecycle Events (newest last)
+	ProcessEvents      []ProcessEvent
+	ShowEvents         bool
+	EventsScrollOffset int
+	EventLogError      string
+
 	// Process Tree View
 	TreeView      bool
 	CollapsedPids map[int32]bool
 
+	// Process Grouping ("", "name", "exe", "user", "cgroup", "container")
+	GroupBy        string
+	ExpandedGroups map[string]bool
+
+	// Container cgroup accounting by container ID
+	ContainerStats map[string]ContainerStats
+
+	// Containers Tab (Engine API)
+	Containers            []Container
+	ContainersErr         string
+	ContainersLoading     bool
+	SelectedContainer     int
+	ContainerScrollOffset int
+	ShowContainerDialog   bool
+	ContainerAction       string // start, stop, restart, pause, unpause
+	ContainerTarget       Container
+
+	// Cgroups Tab
+	Cgroups            []CgroupNode
+	CgroupsErr         string
+	CgroupsLoading     bool
+	SelectedCgroup     int
+	CgroupScrollOffset int
+	CollapsedCgroups   map[string]bool
+
+	// Services Tab (systemd)
+	Services            []Service
+	ServicesErr         string
+	ServicesLoading     bool
+	SelectedService     int
+	ServiceScrollOffset int
+	ShowServiceDialog   bool
+	ServiceAction       string // start, stop, restart
+	ServiceTarget       string // Unit name
+	ShowJournal         bool
+	JournalUnit         string
+	JournalLoading      bool
+	JournalView         SimpleViewport
+
 	// Enhanced Visualization
 	ChartType string
 
@@ -2,8 +2,386 @@ package data
 
 import (
 	"time"
+
+	"github.com/N1xev/bubbleMonitor/src/config"
 )
 
+// Container is a container reported by the Docker/Podman Engine API
+type Container struct {
+	ID           string
+	Name         string
+	Image        string
+	State        string // running, exited, paused, created, restarting, dead
+	Status       string // Human readable, e.g. "Up 2 hours"
+	StartedAt    time.Time
+	RestartCount int
+	CpuPercent   float64
+	MemUsage     uint64
+	MemLimit     uint64
+	NetRx        uint64 // Bytes since start, all networks
+	NetTx        uint64
+	BlockRead    uint64 // Bytes since start, all devices
+	BlockWrite   uint64
+}
+
+// ContainerStats holds cgroup v2 accounting for one container
+type ContainerStats struct {
+	ID            string
+	Runtime       string
+	Cgroup        string
+	CPUUsageUsec  uint64 // cpu.stat usage_usec
+	MemoryCurrent uint64 // memory.current, bytes
+	IOReadBytes   uint64 // io.stat rbytes, all devices
+	IOWriteBytes  uint64 // io.stat wbytes, all devices
+	Time          time.Time
+
+	// Rates from the previous sample, computed on update
+	CpuPercent  float64
+	IOReadRate  float64
+	IOWriteRate float64
+}
+
+// CgroupNode is one cgroup of the cgroup v2 hierarchy
+type CgroupNode struct {
+	Path           string // Relative to the cgroup mount, "/" for the root
+	Name           string
+	Depth          int
+	HasChildren    bool
+	CPUUsageUsec   uint64  // cpu.stat usage_usec
+	MemoryCurrent  uint64  // memory.current, bytes
+	MemoryMax      uint64  // memory.max, 0 when unlimited
+	IOReadBytes    uint64  // io.stat rbytes, all devices
+	IOWriteBytes   uint64  // io.stat wbytes, all devices
+	PidsCurrent    uint64  // pids.current
+	CpuPressure    float64 // PSI "some avg10", percent
+	MemoryPressure float64
+	IOPressure     float64
+	Time           time.Time
+
+	// Rates from the previous sample, computed on update
+	CpuPercent  float64
+	IOReadRate  float64
+	IOWriteRate float64
+}
+
+// Service is a systemd service unit
+type Service struct {
+	Unit          string
+	Description   string
+	LoadState     string // loaded, masked, ...
+	ActiveState   string // active, inactive, failed, activating, deactivating
+	SubState      string // running, exited, dead, ...
+	MainPid       int32
+	NRestarts     int
+	Cgroup        string // Relative to the cgroup mount
+	CPUUsageUsec  uint64 // cpu.stat usage_usec of the unit's cgroup
+	MemoryCurrent uint64 // memory.current of the unit's cgroup, bytes
+	Time          time.Time
+
+	// Rate from the previous sample, computed on update
+	CpuPercent float64
+}
+
+// PressureStat is one line of a PSI file: the share of time some (or all)
+// non-idle tasks were stalled, averaged over 10s, 60s and 300s
+type PressureStat struct {
+	Avg10  float64 // Percent
+	Avg60  float64
+	Avg300 float64
+	Total  uint64 // Cumulative stall time, microseconds
+}
+
+// Pressure holds PSI for one resource. CPU "full" is only reported by
+// kernels 5.13 and later and stays zero otherwise.
+type Pressure struct {
+	Some PressureStat
+	Full PressureStat
+}
+
+// PressureInfo holds system-wide PSI from /proc/pressure
+type PressureInfo struct {
+	Available bool // False when the kernel lacks PSI or it is disabled (psi=0)
+	CPU       Pressure
+	Memory    Pressure
+	IO        Pressure
+}
+
+// CoreTimes is the share of one core's time spent in each state since the
+// previous sample, in percent. User and Nice exclude guest time, which the
+// kernel also counts there.
+type CoreTimes struct {
+	User    float64
+	Nice    float64
+	System  float64
+	Iowait  float64
+	Irq     float64
+	Softirq float64
+	Steal   float64
+	Guest   float64 // Guest and guest_nice
+	Idle    float64
+}
+
+// CoreFreq is a core's clock from cpufreq, in MHz. Zero when unknown.
+type CoreFreq struct {
+	Current float64
+	Max     float64
+}
+
+// Connection is one socket from /proc/net
+type Connection struct {
+	Proto      string // tcp, tcp6, udp, udp6 or unix
+	LocalAddr  string // "ip:port", or the path of a unix socket
+	LocalPort  uint16
+	RemoteAddr string
+	RemotePort uint16
+	State      string // TCP state, UNCONN for unbound UDP, LISTEN/CONNECTED for unix
+	TxQueue    uint64 // Bytes waiting to be sent (accept backlog for listeners)
+	RxQueue    uint64 // Bytes waiting to be read
+	Inode      uint64
+	Uid        int    // Owner from /proc/net, -1 for unix sockets
+	Pid        int32  // 0 when the owning process is not visible
+	Process    string // Name of the owning process
+	User       string
+	RxRate     float64 // Bytes/s received, TCP only
+	TxRate     float64 // Bytes/s acknowledged by the peer, TCP only
+}
+
+// InterfaceInfo describes a network interface. OperState, speed, duplex,
+// driver and kind come from Linux sysfs and are empty elsewhere.
+type InterfaceInfo struct {
+	Name      string
+	OperState string // up, down, dormant, lowerlayerdown, ... (sysfs operstate)
+	Up        bool   // Administratively up
+	Running   bool   // Carrier present
+	Loopback  bool
+	Virtual   bool // No backing device
+	MAC       string
+	MTU       int
+	Speed     int    // Mb/s, 0 when unknown
+	Duplex    string // full or half
+	Driver    string
+	Kind      string   // DEVTYPE, e.g. bridge, vlan or wlan
+	IPv4      []string // Addresses in CIDR notation
+	IPv6      []string
+}
+
+// LinkState returns the operational state, falling back to the interface
+// flags where sysfs has none or reports "unknown" (loopback, tunnels)
+func (i InterfaceInfo) LinkState() string {
+	if i.OperState != "" && i.OperState != "unknown" {
+		return i.OperState
+	}
+	switch {
+	case i.Up && i.Running:
+		return "up"
+	case i.Up:
+		return "no carrier"
+	default:
+		return "down"
+	}
+}
+
+// ProtocolStats is a sample of the kernel's protocol counters from
+// /proc/net/snmp and /proc/net/netstat, keyed "Tcp.RetransSegs",
+// "TcpExt.ListenDrops" and so on
+type ProtocolStats struct {
+	Available bool // False where /proc/net/snmp cannot be read
+	Counters  map[string]uint64
+	Time      time.Time
+}
+
+// ProtocolCounter describes a protocol counter shown as a rate
+type ProtocolCounter struct {
+	Key    string // Key in ProtocolStats.Counters
+	Label  string
+	Error  bool              // Any occurrence is worth noticing
+	Metric config.MetricType // Alert threshold in events/s, if alertable
+}
+
+// ProtocolCounters lists the charted protocol counters in display order
+var ProtocolCounters = []ProtocolCounter{
+	{Key: "Tcp.RetransSegs", Label: "TCP retransmits", Error: true, Metric: config.MetricTCPRetrans},
+	{Key: "Tcp.OutRsts", Label: "TCP resets sent", Error: true, Metric: config.MetricTCPResets},
+	{Key: "Tcp.EstabResets", Label: "TCP established resets", Error: true},
+	{Key: "Tcp.AttemptFails", Label: "TCP failed connects", Error: true},
+	{Key: "Tcp.ActiveOpens", Label: "TCP active opens"},
+	{Key: "Tcp.PassiveOpens", Label: "TCP passive opens"},
+	{Key: "TcpExt.ListenOverflows", Label: "Listen queue overflows", Error: true, Metric: config.MetricListenDrops},
+	{Key: "TcpExt.ListenDrops", Label: "Listen drops", Error: true},
+	{Key: "Udp.InErrors", Label: "UDP receive errors", Error: true, Metric: config.MetricUDPErrors},
+	{Key: "Udp.RcvbufErrors", Label: "UDP receive buffer errors", Error: true},
+	{Key: "Udp.SndbufErrors", Label: "UDP send buffer errors", Error: true},
+	{Key: "Ip.FragFails", Label: "IP fragmentation failures", Error: true},
+	{Key: "Ip.ReasmFails", Label: "IP reassembly failures", Error: true},
+}
+
+// ProbeResult is the outcome of one probe attempt
+type ProbeResult struct {
+	OK        bool
+	Connect   time.Duration // TCP connect
+	Handshake time.Duration // TLS handshake, https only
+	Total     time.Duration // Until the response headers, or connected for tcp
+	Status    int           // HTTP status code
+	Err       string
+	Time      time.Time
+}
+
+// ProbeState holds a probe's latest result and its history. Latencies are
+// in milliseconds, with 0 recorded for failed attempts.
+type ProbeState struct {
+	Last           ProbeResult
+	Running        bool
+	ConnectHistory *RingBuffer
+	TotalHistory   *RingBuffer
+	SuccessHistory *RingBuffer // 1 per successful attempt, 0 per failure
+}
+
+// SuccessRate returns the percentage of successful attempts in the history
+func (p *ProbeState) SuccessRate() float64 {
+	if p.SuccessHistory == nil || p.SuccessHistory.Len() == 0 {
+		return 0
+	}
+	return p.SuccessHistory.Avg() * 100
+}
+
+// WirelessInfo holds the link of a wireless interface
+type WirelessInfo struct {
+	Connected bool // Associated with an access point
+	SSID      string
+	Quality   float64 // Link quality, percent
+	Signal    float64 // dBm
+	Noise     float64 // dBm, 0 where the driver does not report it
+	TxBitrate float64 // Mb/s, from nl80211
+	RxBitrate float64
+}
+
+// SocketBytes holds the lifetime byte counters of a TCP socket from its
+// tcp_info (bytes_received and bytes_acked)
+type SocketBytes struct {
+	Rx uint64
+	Tx uint64
+}
+
+// NetRate is a receive and transmit rate in bytes/s
+type NetRate struct {
+	Rx float64
+	Tx float64
+}
+
+// SocketTraffic is one sample of the TCP socket counters, keyed by socket
+// inode, along with the PID owning each socket. Available is false where
+// sock_diag cannot be queried.
+type SocketTraffic struct {
+	Available bool
+	Sockets   map[uint64]SocketBytes
+	Owners    map[uint64]int32
+	Time      time.Time
+}
+
+// CPUTopology is the processor and NUMA layout. Sockets, caches and nodes
+// are only known where Linux sysfs is available.
+type CPUTopology struct {
+	Available bool // False when only the CPU counts are known
+	Logical   int
+	Cores     int // Physical cores, all sockets
+	Sockets   int
+	CPUs      []CPUPlacement
+	Caches    []CPUCache
+	Nodes     []NumaNode
+}
+
+// CPUPlacement locates a logical CPU in the topology
+type CPUPlacement struct {
+	CPU    int
+	Socket int // physical_package_id
+	Core   int // core_id, unique within a socket
+	Node   int // NUMA node, 0 without NUMA
+}
+
+// CPUCache is one cache level, e.g. L1d or L3
+type CPUCache struct {
+	Level     int
+	Type      string // Data, Instruction or Unified
+	Size      uint64 // Bytes, per instance
+	Instances int    // Distinct caches of this kind in the system
+}
+
+// NumaNode is one NUMA node with its memory and numastat counters
+type NumaNode struct {
+	ID          int
+	CPUList     string // As in sysfs, e.g. "0-7,16-23"
+	MemTotal    uint64
+	MemFree     uint64
+	MemUsed     uint64
+	NumaHit     uint64 // Allocations satisfied from this node as intended
+	NumaMiss    uint64 // Allocated here though another node was preferred
+	NumaForeign uint64 // Intended for here but allocated elsewhere
+	LocalNode   uint64 // Allocated here for a process running on this node
+	OtherNode   uint64 // Allocated here for a process on another node
+}
+
+// InterruptSource is one line of /proc/interrupts or /proc/softirqs
+type InterruptSource struct {
+	Name    string // IRQ number or name, e.g. "36", "NMI", "NET_RX"
+	Device  string // Devices using the IRQ, empty for softirqs
+	Softirq bool
+	Counts  []uint64 // Per CPU since boot
+
+	// Rates from the previous sample, computed on update
+	Rates        []float64 // Per CPU, per second
+	Total        float64   // Sum of Rates
+	Busiest      int       // CPU handling the most of Total
+	BusiestShare float64   // Its share of Total, percent
+}
+
+// InterruptInfo is a sample of the interrupt and softirq counters
+type InterruptInfo struct {
+	Available bool // False where /proc/interrupts cannot be read
+	CPUs      int
+	Sources   []InterruptSource
+	Time      time.Time
+}
+
+// MemoryDetail holds Linux memory counters that gopsutil does not report
+type MemoryDetail struct {
+	Available    bool   // False where /proc/vmstat cannot be read
+	Zswap        uint64 // Compressed zswap pool, bytes
+	Zswapped     uint64 // Uncompressed size of the pages held by zswap
+	ZramDevices  int
+	ZramOrig     uint64 // Uncompressed data stored in zram, all devices
+	ZramCompr    uint64 // Its compressed size
+	ZramUsed     uint64 // Memory used by zram including allocator overhead
+	PageFaults   uint64 // /proc/vmstat pgfault
+	MajorFaults  uint64 // /proc/vmstat pgmajfault
+	SwapInBytes  uint64 // /proc/vmstat pswpin, in bytes
+	SwapOutBytes uint64 // /proc/vmstat pswpout, in bytes
+	Time         time.Time
+
+	// Rates per second from the previous sample, computed on update
+	PageFaultRate  float64
+	MajorFaultRate float64
+	SwapInRate     float64 // Bytes/s
+	SwapOutRate    float64 // Bytes/s
+}
+
+// ProcessEvent records a process starting or exiting
+type ProcessEvent struct {
+	Time       time.Time     `json:"time"`
+	Type       string        `json:"type"` // "start" or "exit"
+	Pid        int32         `json:"pid"`
+	Ppid       int32         `json:"ppid"`
+	Name       string        `json:"name"`
+	Username   string        `json:"user"`
+	Cmdline    string        `json:"cmdline,omitempty"`
+	Lifetime   time.Duration `json:"lifetime_ns,omitempty"` // Exit events only
+	PeakCpu    float64       `json:"peak_cpu,omitempty"`    // Exit events only, percent
+	PeakMemory uint64        `json:"peak_mem_bytes,omitempty"`
+	PidReused  bool          `json:"pid_reused,omitempty"` // The PID was recycled between samples
+}
+
+// MaxProcessEvents limits how many events are kept in memory
+const MaxProcessEvents = 1000
+
 // ProcessInfo holds information about a running process
 type ProcessInfo struct {
 	Name        string
@@ -17,6 +395,17 @@ type ProcessInfo struct {
 	MemoryBytes uint64
 	Nice        int32 // Priority
 	Ppid        int32 // Parent PID
+	Exe         string
+	Cgroup      string  // cgroup path (Linux only)
+	ContainerID string  // Full container ID, empty outside containers
+	Container   string  // Container name, or short ID when unresolved
+	IOReadRate  float64 // Bytes/s
+	IOWriteRate float64 // Bytes/s
+	NetRxRate   float64 // Bytes/s over the process's TCP sockets (Linux only)
+	NetTxRate   float64 // Bytes/s
+
+	// Group is set on the aggregate rows of the grouped process view
+	Group *ProcessGroup
 }
 
 // ProcessSnapshot stores a point-in-time resource snapshot for a process
@@ -28,12 +417,42 @@ type ProcessSnapshot struct {
 
 // DiskPartition holds information about a disk partition
 type DiskPartition struct {
-	Mountpoint string
-	Device     string
-	Fstype     string
-	Total      uint64
-	Used       uint64
-	UsedPct    float64
+	Mountpoint  string
+	Device      string
+	BlockDevice string // Whole device holding it, e.g. "sda" for /dev/sda2, or "" if none
+	Fstype      string
+	Options     []string // Mount options, e.g. rw, noatime
+	Total       uint64
+	Used        uint64
+	UsedPct     float64 // Of the space available to users, as df reports it
+	Available   uint64  // Free to unprivileged users
+	Reserved    uint64  // Free but reserved for root
+	InodesTotal uint64  // 0 where the filesystem allocates inodes dynamically
+	InodesUsed  uint64
+	InodesPct   float64
+	Err         string   // Usage could not be read
+	Hung        bool     // Usage did not return in time, e.g. an unreachable NFS server
+	BindMounts  []string // Further mountpoints of the same filesystem, when grouped
+}
+
+// BlockDevice is a whole disk among the I/O counters
+type BlockDevice struct {
+	Name    string
+	Stacked bool // Built on other devices (device mapper, md), so its I/O is counted twice
+}
+
+// DiskDevice holds a whole disk's activity between two I/O samples
+type DiskDevice struct {
+	Name         string
+	Stacked      bool
+	ReadIOPS     float64
+	WriteIOPS    float64
+	ReadRate     float64 // Bytes/s
+	WriteRate    float64
+	ReadLatency  float64 // Average ms per completed read
+	WriteLatency float64
+	QueueDepth   uint64  // I/Os in flight
+	Util         float64 // Percent of the time busy
 }
 
 // GpuInfo holds information about a GPU
@@ -44,6 +463,22 @@ type GpuInfo struct {
 	MemoryUsed  string
 }
 
+// IOClassNames lists the Linux I/O scheduling classes indexed by class number
+var IOClassNames = []string{"none", "realtime", "best-effort", "idle"}
+
+// SchedInfo holds the scheduling parameters of a process
+type SchedInfo struct {
+	Nice              int
+	IOClass           int    // Index into IOClassNames
+	IOLevel           int    // 0 (highest) to 7 (lowest)
+	Affinity          []bool // Allowed CPUs, indexed by CPU number
+	IOSupported       bool
+	AffinitySupported bool
+}
+
+// SignalNames lists the signals offered by the batch signal dialog
+var SignalNames = []string{"SIGTERM", "SIGINT", "SIGHUP", "SIGQUIT", "SIGKILL"}
+
 // Toast Levels
 const (
 	ToastInfo    = "info"
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/distatus/battery"
+	"github.com/shirou/gopsutil/v3/cpu"
 	"github.com/shirou/gopsutil/v3/disk"
 	"github.com/shirou/gopsutil/v3/host"
 	"github.com/shirou/gopsutil/v3/load"
@@ -27,13 +28,25 @@ type CpuMemMsg struct {
 	LoadAvg    *load.AvgStat
 	MemInfo    *mem.VirtualMemoryStat
 	SwapInfo   *mem.SwapMemoryStat
+	CpuTimes   []cpu.TimesStat // Cumulative per-core times
+	CoreFreqs  []data.CoreFreq
 }
 
-// DiskNetMsg contains slow-updating metrics (Disk, Network)
+// PressureMsg carries Pressure Stall Information
+type PressureMsg data.PressureInfo
+
+// TopologyMsg carries the CPU and NUMA topology
+type TopologyMsg data.CPUTopology
+
+// InterruptsMsg carries the interrupt and softirq counters
+type InterruptsMsg data.InterruptInfo
+
+// MemoryDetailMsg carries zswap, zram and paging counters
+type MemoryDetailMsg data.MemoryDetail
+
+// DiskNetMsg contains slow-updating metrics (root disk usage)
 type DiskNetMsg struct {
-	Disk    float64
-	NetSent uint64
-	NetRecv uint64
+	Disk float64
 }
 
 type MetricsMsg struct {
@@ -50,12 +63,92 @@ type MetricsMsg struct {
 }
 
 type ProcessesMsg []data.ProcessInfo
+
+// ContainersMsg carries the container list from the Engine API
+type ContainersMsg struct {
+	Containers []data.Container
+	Err        error
+}
+
+// ContainerActionMsg reports the result of a container action
+type ContainerActionMsg struct {
+	ID     string
+	Name   string
+	Action string
+	Err    error
+}
+
+// CgroupsMsg carries the cgroup v2 hierarchy, depth-first
+type CgroupsMsg struct {
+	Nodes []data.CgroupNode
+	Err   error
+}
+
+// ConnectionsMsg carries the socket list for the Connections view
+type ConnectionsMsg struct {
+	Connections []data.Connection
+	Err         error
+}
+
+// ProtocolStatsMsg carries a sample of the kernel's protocol counters
+type ProtocolStatsMsg data.ProtocolStats
+
+// ProbeResultMsg carries the outcome of one endpoint probe
+type ProbeResultMsg struct {
+	Name   string
+	Result data.ProbeResult
+}
+
+// SocketTrafficMsg carries a sample of the TCP socket byte counters
+type SocketTrafficMsg data.SocketTraffic
+
+// ServicesMsg carries the service unit list
+type ServicesMsg struct {
+	Services []data.Service
+	Err      error
+}
+
+// ServiceActionMsg reports the result of starting, stopping or restarting a unit
+type ServiceActionMsg struct {
+	Unit   string
+	Action string
+	Err    error
+}
+
+// JournalMsg carries the tail of a unit's journal
+type JournalMsg struct {
+	Unit  string
+	Lines []string
+	Err   error
+}
+
+// ContainerStatsMsg carries cgroup accounting keyed by container ID
+type ContainerStatsMsg map[string]data.ContainerStats
+
+// ProcessEventsMsg carries the processes that started or exited since the last tick
+type ProcessEventsMsg struct {
+	Events []data.ProcessEvent
+	Err    error // Writing the event log failed
+}
 type HostInfoMsg *host.InfoStat
 type DiskInfoMsg []data.DiskPartition // Using data.DiskPartition
 type GpuInfoMsg []data.GpuInfo        // Using data.GpuInfo
-type DiskIOMsg map[string]disk.IOCountersStat
+
+// DiskIOMsg carries the disk I/O counters and which of them are whole devices
+type DiskIOMsg struct {
+	Counters map[string]disk.IOCountersStat
+	Devices  []data.BlockDevice // Whole devices among Counters, by name
+	Time     time.Time
+}
+
 type TempMsg []host.TemperatureStat
 type NetworkInterfacesMsg []net.IOCountersStat
+
+// InterfaceDetailsMsg carries the state, addresses and link of each interface
+type InterfaceDetailsMsg []data.InterfaceInfo
+
+// WirelessMsg carries the link of each wireless interface, by name
+type WirelessMsg map[string]data.WirelessInfo
 type BatteryMsg []*battery.Battery
 
 // Control Messages
@@ -65,6 +158,13 @@ type PriorityChangeMsg struct {
 	Err      error
 }
 
+// SchedInfoMsg carries the current scheduling parameters of a process
+type SchedInfoMsg struct {
+	Pid  int32
+	Info data.SchedInfo
+	Err  error
+}
+
 type ProcessControlMsg struct {
 	Pid    int32
 	Action string // "suspend" or "resume"
@@ -88,6 +188,13 @@ type ToastTimeoutMsg struct {
 	ID int64
 }
 
+// BatchResultMsg summarizes an action applied to several processes
+type BatchResultMsg struct {
+	Action    string
+	Succeeded []int32
+	Failed    map[int32]error
+}
+
 // KillProcessMsg is sent when a process kill is requested
 type KillProcessMsg struct {
 	Pid     int32
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/commands/cgroup"
+	"github.com/N1xev/bubbleMonitor/src/data"
+)
+
+// handleCgroupsKey handles keys on the Cgroups tab. It reports whether the
+// key was consumed.
+func (m *Model) handleCgroupsKey(key string) (tea.Cmd, bool) {
+	visible := m.GetVisibleCgroups()
+	switch key {
+	case "j", "down":
+		if m.SelectedCgroup < len(visible)-1 {
+			m.SelectedCgroup++
+			if rows := m.getVisibleCgroupRows(); m.SelectedCgroup >= m.CgroupScrollOffset+rows {
+				m.CgroupScrollOffset = m.SelectedCgroup - rows + 1
+			}
+		}
+	case "k", "up":
+		if m.SelectedCgroup > 0 {
+			m.SelectedCgroup--
+			if m.SelectedCgroup < m.CgroupScrollOffset {
+				m.CgroupScrollOffset = m.SelectedCgroup
+			}
+		}
+	case "g":
+		m.SelectedCgroup = 0
+		m.CgroupScrollOffset = 0
+	case "G":
+		if len(visible) > 0 {
+			m.SelectedCgroup = len(visible) - 1
+			if rows := m.getVisibleCgroupRows(); m.SelectedCgroup >= rows {
+				m.CgroupScrollOffset = m.SelectedCgroup - rows + 1
+			}
+		}
+	case "space":
+		// Collapse or expand the cgroup under the cursor
+		if m.SelectedCgroup < len(visible) && visible[m.SelectedCgroup].HasChildren {
+			path := visible[m.SelectedCgroup].Path
+			m.CollapsedCgroups[path] = !m.CollapsedCgroups[path]
+		}
+	case "enter":
+		if m.SelectedCgroup < len(visible) {
+			return m.showCgroupProcesses(visible[m.SelectedCgroup]), true
+		}
+	case "r":
+		if !m.CgroupsLoading {
+			m.CgroupsLoading = true
+			return cgroup.TreeCmd(), true
+		}
+	default:
+		return nil, false
+	}
+	return nil, true
+}
+
+// showCgroupProcesses switches to the Processes tab, filtered to the
+// processes in a cgroup and its descendants
+func (m *Model) showCgroupProcesses(node data.CgroupNode) tea.Cmd {
+	procTab := -1
+	for i, tab := range m.ActiveTabs {
+		if tab == "Processes" {
+			procTab = i
+		}
+	}
+	if procTab < 0 {
+		return AddToastCmd("Enable the Processes tab to list cgroup members", data.ToastWarn)
+	}
+
+	if node.Path == "/" {
+		m.SetProcessFilter("")
+	} else {
+		m.SetProcessFilter(fmt.Sprintf(`cgroup~"^%s(/|$)"`, regexp.QuoteMeta(node.Path)))
+	}
+	m.SelectedTab = procTab
+	m.SelectedProcess = 0
+	m.ProcessScrollOffset = 0
+	return nil
+}
+
+// getVisibleCgroupRows returns how many cgroup rows can be displayed
+func (m Model) getVisibleCgroupRows() int {
+	rows := m.Height - 12
+	if rows < 3 {
+		rows = 3
+	}
+	return rows
+}
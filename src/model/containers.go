@@ -0,0 +1,77 @@
+package model
+
+import (
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/commands/container"
+)
+
+// handleContainersKey handles keys on the Containers tab. It reports whether
+// the key was consumed.
+func (m *Model) handleContainersKey(key string) (tea.Cmd, bool) {
+	switch key {
+	case "j", "down":
+		if m.SelectedContainer < len(m.Containers)-1 {
+			m.SelectedContainer++
+			if rows := m.getVisibleContainerRows(); m.SelectedContainer >= m.ContainerScrollOffset+rows {
+				m.ContainerScrollOffset = m.SelectedContainer - rows + 1
+			}
+		}
+	case "k", "up":
+		if m.SelectedContainer > 0 {
+			m.SelectedContainer--
+			if m.SelectedContainer < m.ContainerScrollOffset {
+				m.ContainerScrollOffset = m.SelectedContainer
+			}
+		}
+	case "g":
+		m.SelectedContainer = 0
+		m.ContainerScrollOffset = 0
+	case "G":
+		if len(m.Containers) > 0 {
+			m.SelectedContainer = len(m.Containers) - 1
+			if rows := m.getVisibleContainerRows(); m.SelectedContainer >= rows {
+				m.ContainerScrollOffset = m.SelectedContainer - rows + 1
+			}
+		}
+	case "s":
+		m.openContainerDialog("start")
+	case "t":
+		m.openContainerDialog("stop")
+	case "R":
+		m.openContainerDialog("restart")
+	case "z":
+		m.openContainerDialog("pause")
+	case "x":
+		m.openContainerDialog("unpause")
+	case "r":
+		// Refresh now rather than on the next poll
+		if !m.ContainersLoading {
+			m.ContainersLoading = true
+			return container.ListCmd(m.Config.DockerSocket), true
+		}
+		return nil, true
+	default:
+		return nil, false
+	}
+	return nil, true
+}
+
+// openContainerDialog asks for confirmation before acting on the selected container
+func (m *Model) openContainerDialog(action string) {
+	if m.SelectedContainer < 0 || m.SelectedContainer >= len(m.Containers) {
+		return
+	}
+	m.ShowContainerDialog = true
+	m.ContainerAction = action
+	m.ContainerTarget = m.Containers[m.SelectedContainer]
+}
+
+// getVisibleContainerRows returns how many container rows can be displayed
+func (m Model) getVisibleContainerRows() int {
+	rows := m.Height - 12
+	if rows < 3 {
+		rows = 3
+	}
+	return rows
+}
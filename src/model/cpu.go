@@ -0,0 +1,44 @@
+package model
+
+import (
+	"github.com/shirou/gopsutil/v3/cpu"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+)
+
+// coreTimesBetween turns two cumulative samples of one core into the share
+// of time spent in each state between them
+func coreTimesBetween(prev, cur cpu.TimesStat) data.CoreTimes {
+	// The kernel counts guest time in user and guest_nice in nice as well
+	user := (cur.User - cur.Guest) - (prev.User - prev.Guest)
+	nice := (cur.Nice - cur.GuestNice) - (prev.Nice - prev.GuestNice)
+	guest := (cur.Guest + cur.GuestNice) - (prev.Guest + prev.GuestNice)
+	system := cur.System - prev.System
+	iowait := cur.Iowait - prev.Iowait
+	irq := cur.Irq - prev.Irq
+	softirq := cur.Softirq - prev.Softirq
+	steal := cur.Steal - prev.Steal
+	idle := cur.Idle - prev.Idle
+
+	total := user + nice + guest + system + iowait + irq + softirq + steal + idle
+	if total <= 0 {
+		return data.CoreTimes{}
+	}
+	pct := func(v float64) float64 {
+		if v < 0 {
+			return 0
+		}
+		return v / total * 100
+	}
+	return data.CoreTimes{
+		User:    pct(user),
+		Nice:    pct(nice),
+		System:  pct(system),
+		Iowait:  pct(iowait),
+		Irq:     pct(irq),
+		Softirq: pct(softirq),
+		Steal:   pct(steal),
+		Guest:   pct(guest),
+		Idle:    pct(idle),
+	}
+}
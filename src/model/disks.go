@@ -0,0 +1,89 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// updateDiskIO computes each whole device's activity against the previous
+// sample. The totals add up only devices that are not stacked on others,
+// so a partition, its disk and an LVM volume on it count once.
+func (m *Model) updateDiskIO(msg messages.DiskIOMsg) {
+	if msg.Counters == nil {
+		return
+	}
+	elapsed := msg.Time.Sub(m.LastDiskIOTime).Seconds()
+	if m.DiskUtilHistory == nil {
+		m.DiskUtilHistory = make(map[string]*data.RingBuffer)
+	}
+
+	// Loop and RAM disks only matter when something is mounted from them
+	mounted := make(map[string]bool, len(m.DiskPartitions))
+	for _, part := range m.DiskPartitions {
+		mounted[part.BlockDevice] = true
+	}
+
+	delta := func(cur, prev uint64) float64 {
+		if cur < prev {
+			return 0
+		}
+		return float64(cur - prev)
+	}
+
+	var devices []data.DiskDevice
+	var totalRead, totalWrite float64
+	for _, bd := range msg.Devices {
+		cur := msg.Counters[bd.Name]
+		if cur.ReadCount+cur.WriteCount == 0 {
+			continue
+		}
+		if (strings.HasPrefix(bd.Name, "loop") || strings.HasPrefix(bd.Name, "ram")) && !mounted[bd.Name] {
+			continue
+		}
+
+		dev := data.DiskDevice{Name: bd.Name, Stacked: bd.Stacked, QueueDepth: cur.IopsInProgress}
+		if last, ok := m.LastDiskIO[bd.Name]; ok && elapsed > 0 {
+			reads := delta(cur.ReadCount, last.ReadCount)
+			writes := delta(cur.WriteCount, last.WriteCount)
+			dev.ReadIOPS = reads / elapsed
+			dev.WriteIOPS = writes / elapsed
+			dev.ReadRate = delta(cur.ReadBytes, last.ReadBytes) / elapsed
+			dev.WriteRate = delta(cur.WriteBytes, last.WriteBytes) / elapsed
+			if reads > 0 {
+				dev.ReadLatency = delta(cur.ReadTime, last.ReadTime) / reads
+			}
+			if writes > 0 {
+				dev.WriteLatency = delta(cur.WriteTime, last.WriteTime) / writes
+			}
+			dev.Util = min(delta(cur.IoTime, last.IoTime)/(elapsed*1000)*100, 100)
+		}
+		if !bd.Stacked {
+			totalRead += dev.ReadRate
+			totalWrite += dev.WriteRate
+		}
+
+		if m.DiskUtilHistory[bd.Name] == nil {
+			m.DiskUtilHistory[bd.Name] = data.NewRingBuffer(m.HistoryLength)
+		}
+		m.DiskUtilHistory[bd.Name].Push(dev.Util)
+		devices = append(devices, dev)
+	}
+	for name := range m.DiskUtilHistory {
+		if _, ok := msg.Counters[name]; !ok {
+			delete(m.DiskUtilHistory, name)
+		}
+	}
+
+	if !m.LastDiskIOTime.IsZero() && elapsed > 0 {
+		m.DiskReadRate = totalRead / 1024 / 1024
+		m.DiskWriteRate = totalWrite / 1024 / 1024
+		m.DiskHORead.Push(m.DiskReadRate)
+		m.DiskHOWrite.Push(m.DiskWriteRate)
+	}
+	m.DiskDevices = devices
+	m.DiskIO = msg.Counters
+	m.LastDiskIO = msg.Counters
+	m.LastDiskIOTime = msg.Time
+}
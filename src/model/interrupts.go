@@ -0,0 +1,128 @@
+package model
+
+import (
+	"sort"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/commands/system"
+	"github.com/N1xev/bubbleMonitor/src/data"
+)
+
+// handleMetricsKey handles keys on the Metrics tab. It reports whether the
+// key was consumed.
+func (m *Model) handleMetricsKey(key string) (tea.Cmd, bool) {
+	if key == "v" {
+		if m.MetricsView == "interrupts" {
+			m.MetricsView = ""
+			return nil, true
+		}
+		m.MetricsView = "interrupts"
+		m.InterruptScrollOffset = 0
+		return system.InterruptsCmd(), true
+	}
+	if m.MetricsView != "interrupts" {
+		if key == "N" {
+			// Group the per-core grid by socket, then NUMA node, then not at all
+			switch m.CoreGrouping {
+			case "":
+				m.CoreGrouping = "socket"
+				return AddToastCmd("Cores grouped by socket", data.ToastInfo), true
+			case "socket":
+				m.CoreGrouping = "node"
+				return AddToastCmd("Cores grouped by NUMA node", data.ToastInfo), true
+			default:
+				m.CoreGrouping = ""
+				return AddToastCmd("Cores ungrouped", data.ToastInfo), true
+			}
+		}
+		return nil, false
+	}
+
+	hardware := 0
+	for _, src := range m.Interrupts.Sources {
+		if !src.Softirq {
+			hardware++
+		}
+	}
+	maxOffset := hardware - m.getVisibleInterruptRows()
+	if maxOffset < 0 {
+		maxOffset = 0
+	}
+	switch key {
+	case "j", "down":
+		if m.InterruptScrollOffset < maxOffset {
+			m.InterruptScrollOffset++
+		}
+	case "k", "up":
+		if m.InterruptScrollOffset > 0 {
+			m.InterruptScrollOffset--
+		}
+	case "g":
+		m.InterruptScrollOffset = 0
+	case "G":
+		m.InterruptScrollOffset = maxOffset
+	default:
+		return nil, false
+	}
+	return nil, true
+}
+
+// getVisibleInterruptRows returns how many hardware IRQ rows can be
+// displayed above the softirq box
+func (m Model) getVisibleInterruptRows() int {
+	softHeight := 2
+	for _, src := range m.Interrupts.Sources {
+		if src.Softirq {
+			softHeight++
+		}
+	}
+	if limit := (m.Height - 8) / 2; softHeight > limit {
+		softHeight = limit
+	}
+	rows := m.Height - 14 - softHeight
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
+
+// updateInterrupts stores a new interrupt sample, computing per-CPU rates
+// against the previous one. Sources are kept busiest first.
+func (m *Model) updateInterrupts(info data.InterruptInfo) {
+	type key struct {
+		name    string
+		softirq bool
+	}
+	prev := make(map[key]data.InterruptSource, len(m.Interrupts.Sources))
+	for _, src := range m.Interrupts.Sources {
+		prev[key{src.Name, src.Softirq}] = src
+	}
+
+	elapsed := info.Time.Sub(m.Interrupts.Time).Seconds()
+	for i := range info.Sources {
+		src := &info.Sources[i]
+		src.Rates = make([]float64, len(src.Counts))
+		last, ok := prev[key{src.Name, src.Softirq}]
+		if !ok || elapsed <= 0 || len(last.Counts) != len(src.Counts) {
+			continue
+		}
+		for cpu, count := range src.Counts {
+			if count >= last.Counts[cpu] {
+				src.Rates[cpu] = float64(count-last.Counts[cpu]) / elapsed
+			}
+			src.Total += src.Rates[cpu]
+			if src.Rates[cpu] > src.Rates[src.Busiest] {
+				src.Busiest = cpu
+			}
+		}
+		if src.Total > 0 {
+			src.BusiestShare = src.Rates[src.Busiest] / src.Total * 100
+		}
+	}
+
+	sort.SliceStable(info.Sources, func(i, j int) bool {
+		return info.Sources[i].Total > info.Sources[j].Total
+	})
+	m.Interrupts = info
+}
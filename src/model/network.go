@@ -0,0 +1,339 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/shirou/gopsutil/v3/net"
+
+	"github.com/N1xev/bubbleMonitor/src/commands/netstat"
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/ui/tabs"
+)
+
+// networkViews lists the Network tab views in the order v cycles them
+var networkViews = []string{"", "connections", "protocols", "probes"}
+
+// handleNetworkKey handles keys on the Network tab. It reports whether the
+// key was consumed.
+func (m *Model) handleNetworkKey(key string) (tea.Cmd, bool) {
+	if key == "v" {
+		for i, view := range networkViews {
+			if view == m.NetworkView {
+				m.NetworkView = networkViews[(i+1)%len(networkViews)]
+				break
+			}
+		}
+		return m.refreshNetworkView(), true
+	}
+	if m.NetworkView == "probes" && key == "r" {
+		if len(m.Config.Probes) == 0 {
+			return AddToastCmd("No probes configured", data.ToastWarn), true
+		}
+		return tea.Batch(m.runProbes(true)...), true
+	}
+	if m.NetworkView != "connections" {
+		return nil, false
+	}
+
+	conns := m.GetFilteredConnections()
+	switch key {
+	case "j", "down":
+		if m.SelectedConnection < len(conns)-1 {
+			m.SelectedConnection++
+			if rows := m.getVisibleConnectionRows(); m.SelectedConnection >= m.ConnScrollOffset+rows {
+				m.ConnScrollOffset = m.SelectedConnection - rows + 1
+			}
+		}
+	case "k", "up":
+		if m.SelectedConnection > 0 {
+			m.SelectedConnection--
+			if m.SelectedConnection < m.ConnScrollOffset {
+				m.ConnScrollOffset = m.SelectedConnection
+			}
+		}
+	case "g":
+		m.SelectedConnection = 0
+		m.ConnScrollOffset = 0
+	case "G":
+		if len(conns) > 0 {
+			m.SelectedConnection = len(conns) - 1
+			if rows := m.getVisibleConnectionRows(); m.SelectedConnection >= rows {
+				m.ConnScrollOffset = m.SelectedConnection - rows + 1
+			}
+		}
+	case "f":
+		m.ConnFilterMode = true
+	case "c":
+		m.SetConnFilter("")
+		m.SelectedConnection = 0
+		m.ConnScrollOffset = 0
+	case "enter":
+		if m.SelectedConnection < len(conns) {
+			return m.showConnectionProcess(conns[m.SelectedConnection]), true
+		}
+	case "r":
+		return m.refreshNetworkView(), true
+	default:
+		return nil, false
+	}
+	return nil, true
+}
+
+// handleConnFilterKey edits the connection filter while it is being typed
+func (m *Model) handleConnFilterKey(key string) {
+	switch key {
+	case "esc", "enter":
+		m.ConnFilterMode = false
+	case "backspace":
+		if len(m.ConnFilter) > 0 {
+			m.SetConnFilter(m.ConnFilter[:len(m.ConnFilter)-1])
+		}
+	case "space":
+		m.SetConnFilter(m.ConnFilter + " ")
+	default:
+		if len(key) == 1 {
+			m.SetConnFilter(m.ConnFilter + key)
+		}
+	}
+	m.SelectedConnection = 0
+	m.ConnScrollOffset = 0
+}
+
+// refreshNetworkView starts loading the data of the current Network view
+func (m *Model) refreshNetworkView() tea.Cmd {
+	if m.NetworkView == "connections" && !m.ConnectionsLoading {
+		m.ConnectionsLoading = true
+		return netstat.ConnectionsCmd()
+	}
+	return nil
+}
+
+// showConnectionProcess switches to the Processes tab filtered to the
+// process owning a socket
+func (m *Model) showConnectionProcess(conn data.Connection) tea.Cmd {
+	if conn.Pid == 0 {
+		return AddToastCmd("Owner not visible; run as root to see other users' sockets", data.ToastWarn)
+	}
+	procTab := -1
+	for i, tab := range m.ActiveTabs {
+		if tab == "Processes" {
+			procTab = i
+		}
+	}
+	if procTab < 0 {
+		return AddToastCmd("Enable the Processes tab to show the owning process", data.ToastWarn)
+	}
+
+	m.SetProcessFilter(fmt.Sprintf("pid=%d", conn.Pid))
+	m.SelectedTab = procTab
+	m.SelectedProcess = 0
+	m.ProcessScrollOffset = 0
+	return nil
+}
+
+// getVisibleConnectionRows returns how many connection rows can be displayed
+// below the state summary and filter lines
+func (m Model) getVisibleConnectionRows() int {
+	rows := m.Height - 14
+	if rows < 3 {
+		rows = 3
+	}
+	return rows
+}
+
+// updateSocketTraffic turns two consecutive socket samples into per-socket
+// rates and sums them per owning process. A socket first seen in this
+// sample is assumed to have moved all its bytes since the previous one, so
+// short transfers still show up; sockets closed in between are lost.
+func (m *Model) updateSocketTraffic(sample data.SocketTraffic) {
+	last := m.SocketTraffic
+	m.SocketTraffic = sample
+
+	// Samples stop while no view needs them; a stale previous sample would
+	// average the first rates over the whole gap
+	elapsed := sample.Time.Sub(last.Time).Seconds()
+	if !sample.Available || !last.Available || elapsed <= 0 || elapsed > 4*float64(m.RefreshRate)/1000 {
+		m.SocketRates = nil
+		m.ProcNetRates = nil
+		m.applyNetRates()
+		return
+	}
+
+	m.SocketRates = make(map[uint64]data.NetRate, len(sample.Sockets))
+	m.ProcNetRates = make(map[int32]data.NetRate)
+	for inode, cur := range sample.Sockets {
+		prev := last.Sockets[inode]
+		if cur.Rx < prev.Rx || cur.Tx < prev.Tx {
+			// The inode was reused by a new socket
+			prev = data.SocketBytes{}
+		}
+		rate := data.NetRate{
+			Rx: float64(cur.Rx-prev.Rx) / elapsed,
+			Tx: float64(cur.Tx-prev.Tx) / elapsed,
+		}
+		if rate.Rx == 0 && rate.Tx == 0 {
+			continue
+		}
+		m.SocketRates[inode] = rate
+		if pid, ok := sample.Owners[inode]; ok {
+			total := m.ProcNetRates[pid]
+			total.Rx += rate.Rx
+			total.Tx += rate.Tx
+			m.ProcNetRates[pid] = total
+		}
+	}
+	m.applyNetRates()
+}
+
+// applyNetRates copies the latest socket rates onto the process list and
+// the connections, which are replaced independently of the samples
+func (m *Model) applyNetRates() {
+	for i := range m.Processes {
+		rate := m.ProcNetRates[m.Processes[i].Pid]
+		m.Processes[i].NetRxRate = rate.Rx
+		m.Processes[i].NetTxRate = rate.Tx
+	}
+	for i := range m.Connections {
+		rate := m.SocketRates[m.Connections[i].Inode]
+		m.Connections[i].RxRate = rate.Rx
+		m.Connections[i].TxRate = rate.Tx
+	}
+}
+
+// wantsSocketTraffic reports whether a view showing socket rates is on
+// screen. Owners are found by reading every process's fds, so the
+// Processes tab only samples when its NET columns fit.
+func (m Model) wantsSocketTraffic() bool {
+	switch m.currentTab() {
+	case "Processes":
+		return tabs.NetColumnsFit(&m.AppState)
+	case "Network":
+		return m.NetworkView == "connections"
+	}
+	return false
+}
+
+// updateInterfaceRates computes each interface's rates against the previous
+// counters and records them in its own history, dropping interfaces that
+// have disappeared. The totals leave out loopback traffic.
+func (m *Model) updateInterfaceRates(counters []net.IOCountersStat) {
+	now := time.Now()
+	elapsed := now.Sub(m.LastNetworkSample).Seconds()
+	if m.LastNetworkInterfaces == nil {
+		m.LastNetworkInterfaces = make(map[string]net.IOCountersStat)
+	}
+	if m.InterfaceRxHistory == nil {
+		m.InterfaceRxHistory = make(map[string]*data.RingBuffer)
+		m.InterfaceTxHistory = make(map[string]*data.RingBuffer)
+	}
+
+	rates := make(map[string]data.NetRate, len(counters))
+	var total data.NetRate
+	seen := make(map[string]bool, len(counters))
+	for _, nic := range counters {
+		seen[nic.Name] = true
+		var rate data.NetRate
+		if last, ok := m.LastNetworkInterfaces[nic.Name]; ok && elapsed > 0 {
+			// Counters restart when a driver is reloaded
+			if nic.BytesRecv >= last.BytesRecv {
+				rate.Rx = float64(nic.BytesRecv-last.BytesRecv) / elapsed
+			}
+			if nic.BytesSent >= last.BytesSent {
+				rate.Tx = float64(nic.BytesSent-last.BytesSent) / elapsed
+			}
+		}
+		rates[nic.Name] = rate
+		if !m.isLoopback(nic.Name) {
+			total.Rx += rate.Rx
+			total.Tx += rate.Tx
+		}
+		m.LastNetworkInterfaces[nic.Name] = nic
+
+		if m.InterfaceRxHistory[nic.Name] == nil {
+			m.InterfaceRxHistory[nic.Name] = data.NewRingBuffer(m.HistoryLength)
+			m.InterfaceTxHistory[nic.Name] = data.NewRingBuffer(m.HistoryLength)
+		}
+		m.InterfaceRxHistory[nic.Name].Push(rate.Rx)
+		m.InterfaceTxHistory[nic.Name].Push(rate.Tx)
+	}
+	for name := range m.LastNetworkInterfaces {
+		if !seen[name] {
+			delete(m.LastNetworkInterfaces, name)
+			delete(m.InterfaceRxHistory, name)
+			delete(m.InterfaceTxHistory, name)
+		}
+	}
+
+	m.NetworkInterfaces = counters
+	m.InterfaceRates = rates
+	m.LastNetworkSample = now
+	m.NetRecvRate = total.Rx
+	m.NetSentRate = total.Tx
+	m.NetRxHistory.Push(total.Rx)
+	m.NetTxHistory.Push(total.Tx)
+}
+
+// isLoopback reports whether an interface is a loopback device, by name
+// until its details have been read
+func (m Model) isLoopback(name string) bool {
+	if info, ok := m.InterfaceDetails[name]; ok {
+		return info.Loopback
+	}
+	return name == "lo" || name == "lo0"
+}
+
+// updateProtocolStats turns two consecutive protocol samples into rates and
+// records the charted counters in their histories
+func (m *Model) updateProtocolStats(stats data.ProtocolStats) {
+	last := m.ProtocolStats
+	m.ProtocolStats = stats
+	if m.ProtocolHistory == nil {
+		m.ProtocolHistory = make(map[string]*data.RingBuffer)
+	}
+
+	elapsed := stats.Time.Sub(last.Time).Seconds()
+	if !stats.Available || !last.Available || elapsed <= 0 {
+		m.ProtocolRates = nil
+		return
+	}
+	rates := make(map[string]float64, len(stats.Counters))
+	for key, cur := range stats.Counters {
+		// Counters restart only at boot, but some wrap at 32 bits
+		if prev, ok := last.Counters[key]; ok && cur >= prev {
+			rates[key] = float64(cur-prev) / elapsed
+		}
+	}
+	m.ProtocolRates = rates
+
+	for _, counter := range data.ProtocolCounters {
+		if _, ok := stats.Counters[counter.Key]; !ok {
+			continue
+		}
+		if m.ProtocolHistory[counter.Key] == nil {
+			m.ProtocolHistory[counter.Key] = data.NewRingBuffer(m.HistoryLength)
+		}
+		m.ProtocolHistory[counter.Key].Push(rates[counter.Key])
+	}
+}
+
+// updateWireless stores the wireless links and records each one's quality,
+// dropping the histories of interfaces that have disappeared
+func (m *Model) updateWireless(links map[string]data.WirelessInfo) {
+	if m.SignalHistory == nil {
+		m.SignalHistory = make(map[string]*data.RingBuffer)
+	}
+	for name, link := range links {
+		if m.SignalHistory[name] == nil {
+			m.SignalHistory[name] = data.NewRingBuffer(m.HistoryLength)
+		}
+		m.SignalHistory[name].Push(link.Quality)
+	}
+	for name := range m.SignalHistory {
+		if _, ok := links[name]; !ok {
+			delete(m.SignalHistory, name)
+		}
+	}
+	m.Wireless = links
+}
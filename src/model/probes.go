@@ -0,0 +1,85 @@
+package model
+
+import (
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/commands/probe"
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/messages"
+)
+
+// runProbes starts the configured probes that are due, or all idle ones
+// when forced. Probes removed from the config are dropped.
+func (m *Model) runProbes(force bool) []tea.Cmd {
+	if len(m.Config.Probes) == 0 {
+		m.Probes = nil
+		return nil
+	}
+	if m.Probes == nil {
+		m.Probes = make(map[string]*data.ProbeState)
+	}
+
+	var cmds []tea.Cmd
+	configured := make(map[string]bool, len(m.Config.Probes))
+	for _, cfg := range m.Config.Probes {
+		configured[cfg.Name] = true
+		state := m.Probes[cfg.Name]
+		if state == nil {
+			state = m.newProbeState()
+			m.Probes[cfg.Name] = state
+		}
+		due := time.Since(state.Last.Time) >= time.Duration(cfg.Interval)*time.Second
+		if state.Running || !(force || due) {
+			continue
+		}
+		state.Running = true
+		cmds = append(cmds, probe.RunCmd(cfg))
+	}
+	for name := range m.Probes {
+		if !configured[name] {
+			delete(m.Probes, name)
+		}
+	}
+	return cmds
+}
+
+// updateProbe records the outcome of a probe attempt
+func (m *Model) updateProbe(msg messages.ProbeResultMsg) {
+	state := m.Probes[msg.Name]
+	if state == nil {
+		// Removed from the config while running
+		return
+	}
+	state.Last = msg.Result
+	state.Running = false
+
+	// Failures only count against the success rate; a zero in the latency
+	// histories would read as an instant response
+	if msg.Result.OK {
+		state.ConnectHistory.Push(float64(msg.Result.Connect) / float64(time.Millisecond))
+		state.TotalHistory.Push(float64(msg.Result.Total) / float64(time.Millisecond))
+		state.SuccessHistory.Push(1)
+	} else {
+		state.SuccessHistory.Push(0)
+	}
+}
+
+// resetProbeHistories recreates the probe histories after H changes their length
+func (m *Model) resetProbeHistories() {
+	for name, state := range m.Probes {
+		fresh := m.newProbeState()
+		fresh.Last = state.Last
+		fresh.Running = state.Running
+		m.Probes[name] = fresh
+	}
+}
+
+func (m *Model) newProbeState() *data.ProbeState {
+	return &data.ProbeState{
+		ConnectHistory: data.NewRingBuffer(m.HistoryLength),
+		TotalHistory:   data.NewRingBuffer(m.HistoryLength),
+		SuccessHistory: data.NewRingBuffer(m.HistoryLength),
+	}
+}
@@ -0,0 +1,116 @@
+package model
+
+import (
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/N1xev/bubbleMonitor/src/commands/services"
+)
+
+// handleServicesKey handles keys on the Services tab. It reports whether
+// the key was consumed.
+func (m *Model) handleServicesKey(key string) (tea.Cmd, bool) {
+	switch key {
+	case "j", "down":
+		if m.SelectedService < len(m.Services)-1 {
+			m.SelectedService++
+			if rows := m.getVisibleServiceRows(); m.SelectedService >= m.ServiceScrollOffset+rows {
+				m.ServiceScrollOffset = m.SelectedService - rows + 1
+			}
+		}
+	case "k", "up":
+		if m.SelectedService > 0 {
+			m.SelectedService--
+			if m.SelectedService < m.ServiceScrollOffset {
+				m.ServiceScrollOffset = m.SelectedService
+			}
+		}
+	case "g":
+		m.SelectedService = 0
+		m.ServiceScrollOffset = 0
+	case "G":
+		if len(m.Services) > 0 {
+			m.SelectedService = len(m.Services) - 1
+			if rows := m.getVisibleServiceRows(); m.SelectedService >= rows {
+				m.ServiceScrollOffset = m.SelectedService - rows + 1
+			}
+		}
+	case "s":
+		m.openServiceDialog("start")
+	case "t":
+		m.openServiceDialog("stop")
+	case "R":
+		m.openServiceDialog("restart")
+	case "enter":
+		// Tail the journal of the selected unit
+		if m.SelectedService < len(m.Services) {
+			m.ShowJournal = true
+			m.JournalUnit = m.Services[m.SelectedService].Unit
+			m.JournalView.Height = m.getJournalRows()
+			m.JournalView.SetContent("Loading journal...")
+			m.JournalLoading = true
+			return services.JournalCmd(m.serviceManager, m.JournalUnit), true
+		}
+	case "r":
+		if !m.ServicesLoading {
+			m.ServicesLoading = true
+			return services.ListCmd(m.serviceManager), true
+		}
+	default:
+		return nil, false
+	}
+	return nil, true
+}
+
+// handleJournalKey handles keys while the journal viewer is open
+func (m *Model) handleJournalKey(key string) {
+	switch key {
+	case "enter", "esc":
+		m.ShowJournal = false
+		m.JournalUnit = ""
+	case "j", "down":
+		m.JournalView.LineDown(1)
+	case "k", "up":
+		m.JournalView.LineUp(1)
+	case "pgdown", "ctrl+d":
+		m.JournalView.HalfViewDown()
+	case "pgup", "ctrl+u":
+		m.JournalView.HalfViewUp()
+	case "g", "home":
+		m.JournalView.GotoTop()
+	case "G", "end":
+		m.JournalView.GotoBottom()
+	}
+}
+
+// openServiceDialog asks for confirmation before acting on the selected unit
+func (m *Model) openServiceDialog(action string) {
+	if m.SelectedService < 0 || m.SelectedService >= len(m.Services) {
+		return
+	}
+	m.ShowServiceDialog = true
+	m.ServiceAction = action
+	m.ServiceTarget = m.Services[m.SelectedService].Unit
+}
+
+// getVisibleServiceRows returns how many service rows can be displayed
+func (m Model) getVisibleServiceRows() int {
+	rows := m.Height - 12
+	if rows < 3 {
+		rows = 3
+	}
+	return rows
+}
+
+// getJournalRows returns how many journal lines fit in the journal viewer.
+// Must match the box size in overlays.RenderJournalOverlay.
+func (m Model) getJournalRows() int {
+	boxHeight := 30
+	if boxHeight > m.Height-4 {
+		boxHeight = m.Height - 4
+	}
+	rows := boxHeight - 4
+	if rows < 5 {
+		rows = 5
+	}
+	return rows
+}
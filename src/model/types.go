@@ -6,6 +6,7 @@ import (
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 	"github.com/N1xev/bubbleMonitor/src/commands/process"
+	"github.com/N1xev/bubbleMonitor/src/commands/services"
 	"github.com/N1xev/bubbleMonitor/src/commands/system"
 	configpkg "github.com/N1xev/bubbleMonitor/src/config"
 	"github.com/N1xev/bubbleMonitor/src/data"
@@ -15,6 +16,9 @@ import (
 
 type Model struct {
 	data.AppState
+
+	// serviceManager runs the Services tab's systemd queries and actions
+	serviceManager services.Manager
 }
 
 // Init initializes the model and returns start commands
@@ -22,15 +26,20 @@ func (m Model) Init() tea.Cmd {
 	return tea.Batch(
 		system.TickCmd(time.Duration(m.RefreshRate)*time.Millisecond),
 		system.FastMetricsCmd(),
+		system.PressureCmd(),
+		system.ProtocolStatsCmd(),
 		system.SlowMetricsCmd(),
 		process.ProcessesCmd(m.SortBy),
 		system.HostInfoCmd(),
-		system.DiskInfoCmd(),
+		system.DiskInfoCmd(m.Config.Disks),
 		system.DiskIOCmd(),
 		system.TempCmd(),
 		system.NetworkInterfacesCmd(),
+		system.InterfaceDetailsCmd(),
+		system.WirelessCmd(),
 		system.BatteryCmd(),
 		system.GpuInfoCmd(),
+		system.TopologyCmd(),
 		configpkg.WatchConfig(m.LastConfigModTime),
 	)
 }
@@ -88,11 +97,17 @@ func InitialModel() Model {
 			HistoryLength:     cfg.HistoryLength,
 			CpuHistory:        data.NewRingBuffer(cfg.HistoryLength),
 			MemHistory:        data.NewRingBuffer(cfg.HistoryLength),
-			NetHistory:        data.NewRingBuffer(cfg.HistoryLength),
+			NetRxHistory:      data.NewRingBuffer(cfg.HistoryLength),
+			NetTxHistory:      data.NewRingBuffer(cfg.HistoryLength),
 			SwapHistory:       data.NewRingBuffer(cfg.HistoryLength),
 			DiskHORead:        data.NewRingBuffer(cfg.HistoryLength),
 			DiskHOWrite:       data.NewRingBuffer(cfg.HistoryLength),
 			HistoryTemp:       data.NewRingBuffer(cfg.HistoryLength),
+			MemBuffersHistory: data.NewRingBuffer(cfg.HistoryLength),
+			MemCachedHistory:  data.NewRingBuffer(cfg.HistoryLength),
+			CpuPSIHistory:     data.NewRingBuffer(cfg.HistoryLength),
+			MemPSIHistory:     data.NewRingBuffer(cfg.HistoryLength),
+			IOPSIHistory:      data.NewRingBuffer(cfg.HistoryLength),
 			Processes:         []data.ProcessInfo{},
 			StartTime:         time.Now(),
 			Toasts:            []data.Toast{},
@@ -100,6 +115,12 @@ func InitialModel() Model {
 			ChartType:         cfg.ChartType,
 			TreeView:          cfg.ViewType == "tree",
 			CollapsedPids:     make(map[int32]bool),
+			CollapsedCgroups:  make(map[string]bool),
+			GroupBy:           cfg.GroupBy,
+			ExpandedGroups:    make(map[string]bool),
+			MarkedPids:        make(map[int32]bool),
+			FilterHistoryIdx:  -1,
+			SavedFilterIdx:    -1,
 			SortBy:            cfg.SortBy,
 			CpuInfoStatic:     cpuInfo, // Static CPU info fetched once
 			Theme:             cfg.Theme,
@@ -110,6 +131,8 @@ func InitialModel() Model {
 			LastConfigModTime: time.Now(),
 			ActiveTabs:        cfg.Tabs,
 			OpenFilesView:     data.NewSimpleViewport(0, 0),
+			JournalView:       data.NewSimpleViewport(0, 0),
 		},
+		serviceManager: services.NewSystemctl(),
 	}
 }
@@ -4,13 +4,17 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
-	"github.com/shirou/gopsutil/v3/net"
 
+	"github.com/N1xev/bubbleMonitor/src/commands/cgroup"
+	"github.com/N1xev/bubbleMonitor/src/commands/container"
+	"github.com/N1xev/bubbleMonitor/src/commands/netstat"
 	"github.com/N1xev/bubbleMonitor/src/commands/process"
+	"github.com/N1xev/bubbleMonitor/src/commands/services"
 	"github.com/N1xev/bubbleMonitor/src/commands/system"
 	"github.com/N1xev/bubbleMonitor/src/config"
 	"github.com/N1xev/bubbleMonitor/src/data"
@@ -45,6 +49,56 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Refresh processes after kill
 		return m, process.ProcessesCmd(m.SortBy)
 
+	case messages.BatchResultMsg:
+		m.ShowBatchDialog = false
+		m.BatchTargets = nil
+		for _, pid := range msg.Succeeded {
+			switch msg.Action {
+			case "suspend":
+				m.SuspendedState[pid] = true
+			case "resume":
+				delete(m.SuspendedState, pid)
+			}
+		}
+		if msg.Action == "kill" || msg.Action == "signal" {
+			m.MarkedPids = make(map[int32]bool)
+		}
+
+		summary := fmt.Sprintf("%s: %d succeeded", strings.Title(msg.Action), len(msg.Succeeded))
+		level := data.ToastSuccess
+		if len(msg.Failed) > 0 {
+			summary += fmt.Sprintf(", %d failed", len(msg.Failed))
+			level = data.ToastWarn
+			if len(msg.Succeeded) == 0 {
+				level = data.ToastError
+			}
+			// Report the lowest failing PID so the toast is stable
+			var firstPid int32 = -1
+			for pid := range msg.Failed {
+				if firstPid == -1 || pid < firstPid {
+					firstPid = pid
+				}
+			}
+			summary += fmt.Sprintf(" (PID %d: %v)", firstPid, msg.Failed[firstPid])
+		}
+		return m, tea.Batch(process.ProcessesCmd(m.SortBy), AddToastCmd(summary, level))
+
+	case messages.SchedInfoMsg:
+		if !m.ShowSchedDialog || msg.Pid != m.SchedPid {
+			return m, nil
+		}
+		if msg.Err != nil {
+			m.ShowSchedDialog = false
+			return m, AddToastCmd(fmt.Sprintf("Scheduling Error: %v", msg.Err), data.ToastError)
+		}
+		m.SchedLoading = false
+		m.SchedOriginal = msg.Info
+		m.SchedInfo = msg.Info
+		// Edit a copy so the original mask stays intact for the diff on apply
+		m.SchedInfo.Affinity = append([]bool(nil), msg.Info.Affinity...)
+		m.SchedField = 0
+		m.SchedCpuCursor = 0
+
 	case messages.PriorityChangeMsg:
 		if msg.Err != nil {
 			return m, AddToastCmd(fmt.Sprintf("Priority Error: %v", msg.Err), data.ToastError)
@@ -123,6 +177,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.ChartType = newConfig.ChartType
 			m.SortBy = newConfig.SortBy
 			m.TreeView = newConfig.ViewType == "tree"
+			m.GroupBy = newConfig.GroupBy
 			m.Theme = newConfig.Theme
 			m.RefreshRate = newConfig.RefreshRate
 			m.BorderType = newConfig.BorderType
@@ -150,6 +205,65 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Handle container action dialog
+		if m.ShowContainerDialog {
+			switch msg.String() {
+			case "y", "enter":
+				m.ShowContainerDialog = false
+				return m, container.ActionCmd(m.Config.DockerSocket, m.ContainerTarget, m.ContainerAction)
+			case "n", "esc":
+				m.ShowContainerDialog = false
+			}
+			return m, nil
+		}
+
+		// Handle service action dialog
+		if m.ShowServiceDialog {
+			switch msg.String() {
+			case "y", "enter":
+				m.ShowServiceDialog = false
+				return m, services.ActionCmd(m.serviceManager, m.ServiceTarget, m.ServiceAction)
+			case "n", "esc":
+				m.ShowServiceDialog = false
+			}
+			return m, nil
+		}
+
+		// Handle batch action dialog
+		if m.ShowBatchDialog {
+			switch msg.String() {
+			case "y", "enter":
+				pids := make([]int32, 0, len(m.BatchTargets))
+				for _, proc := range m.BatchTargets {
+					pids = append(pids, proc.Pid)
+				}
+				m.ShowBatchDialog = false
+				return m, process.BatchCmd(m.BatchAction, pids, m.BatchSignal, m.BatchDelta)
+			case "n", "esc":
+				m.ShowBatchDialog = false
+				m.BatchTargets = nil
+			case "left", "h", "right", "l":
+				if m.BatchAction == "signal" {
+					dir := 1
+					if msg.String() == "left" || msg.String() == "h" {
+						dir = -1
+					}
+					for i, name := range data.SignalNames {
+						if name == m.BatchSignal {
+							m.BatchSignal = data.SignalNames[(i+dir+len(data.SignalNames))%len(data.SignalNames)]
+							break
+						}
+					}
+				}
+			}
+			return m, nil
+		}
+
+		// Handle scheduling dialog
+		if m.ShowSchedDialog {
+			return m.handleSchedKey(msg.String())
+		}
+
 		// Handle help overlay
 		if m.ShowHelp {
 			if msg.String() == "?" || msg.String() == "esc" {
@@ -185,6 +299,50 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Handle journal viewer
+		if m.ShowJournal {
+			m.handleJournalKey(msg.String())
+			return m, nil
+		}
+
+		// Handle Events panel
+		if m.ShowEvents {
+			maxOffset := len(m.ProcessEvents) - m.getEventsPanelRows()
+			if maxOffset < 0 {
+				maxOffset = 0
+			}
+			switch msg.String() {
+			case "e", "esc":
+				m.ShowEvents = false
+			case "j", "down":
+				if m.EventsScrollOffset < maxOffset {
+					m.EventsScrollOffset++
+				}
+			case "k", "up":
+				if m.EventsScrollOffset > 0 {
+					m.EventsScrollOffset--
+				}
+			case "pgdown", "ctrl+d":
+				m.EventsScrollOffset += m.getEventsPanelRows() / 2
+				if m.EventsScrollOffset > maxOffset {
+					m.EventsScrollOffset = maxOffset
+				}
+			case "pgup", "ctrl+u":
+				m.EventsScrollOffset -= m.getEventsPanelRows() / 2
+				if m.EventsScrollOffset < 0 {
+					m.EventsScrollOffset = 0
+				}
+			case "g", "home":
+				m.EventsScrollOffset = 0
+			case "G", "end":
+				m.EventsScrollOffset = maxOffset
+			case "c":
+				m.ProcessEvents = nil
+				m.EventsScrollOffset = 0
+			}
+			return m, nil
+		}
+
 		// Handle filter mode
 		if m.FilterMode {
 			switch msg.String() {
@@ -192,14 +350,34 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.FilterMode = false
 			case "backspace":
 				if len(m.ProcessFilter) > 0 {
-					m.ProcessFilter = m.ProcessFilter[:len(m.ProcessFilter)-1]
+					m.SetProcessFilter(m.ProcessFilter[:len(m.ProcessFilter)-1])
 				}
 			case "enter":
 				m.FilterMode = false
+				if m.FilterError == "" {
+					m.pushFilterHistory(m.ProcessFilter)
+				}
+			case "up", "down":
+				// Browse previously applied filters
+				history := m.Config.FilterHistory
+				if len(history) > 0 {
+					if msg.String() == "up" && m.FilterHistoryIdx < len(history)-1 {
+						m.FilterHistoryIdx++
+					} else if msg.String() == "down" && m.FilterHistoryIdx >= 0 {
+						m.FilterHistoryIdx--
+					}
+					if m.FilterHistoryIdx >= 0 {
+						m.SetProcessFilter(history[m.FilterHistoryIdx])
+					} else {
+						m.SetProcessFilter("")
+					}
+				}
+			case "space":
+				m.SetProcessFilter(m.ProcessFilter + " ")
 			default:
 				// Add character to filter if it's printable
 				if len(msg.String()) == 1 {
-					m.ProcessFilter += msg.String()
+					m.SetProcessFilter(m.ProcessFilter + msg.String())
 				}
 			}
 			// Reset selection when filter changes
@@ -208,14 +386,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Connection filter being typed on the Network tab
+		if m.ConnFilterMode {
+			m.handleConnFilterKey(msg.String())
+			return m, nil
+		}
+
 		// Settings overlay key handling
 		if m.ShowSettings {
-			// Total settings: 19
-			// 4 Thresholds (0-3)
-			// 4 Display (4-7)
-			// 6 Tabs (8-13)
-			// 5 Appearance (14-18)
-			totalSettings := 19
+			// Thresholds (0 to settingsDisplayBase-1)
+			// 4 Display (settingsDisplayBase to settingsTabsBase-1)
+			// Tabs (settingsTabsBase to settingsTabsBase+len(AllTabs)-1)
+			// 5 Appearance (after the tabs)
+			totalSettings := settingsTabsBase + len(config.AllTabs()) + 5
 
 			switch msg.String() {
 			case "esc", ".":
@@ -225,21 +408,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case "up", "k":
 				m.SettingsIdx = (m.SettingsIdx - 1 + totalSettings) % totalSettings
 				// Update SettingsSel for threshold items
-				if m.SettingsIdx < 4 {
-					metrics := []config.MetricType{config.MetricCPU, config.MetricMem, config.MetricDisk, config.MetricTemp}
-					m.SettingsSel = metrics[m.SettingsIdx]
+				if m.SettingsIdx < settingsDisplayBase {
+					m.SettingsSel = settingsThresholds[m.SettingsIdx].Metric
 				}
 			case "down", "j":
 				m.SettingsIdx = (m.SettingsIdx + 1) % totalSettings
-				if m.SettingsIdx < 4 {
-					metrics := []config.MetricType{config.MetricCPU, config.MetricMem, config.MetricDisk, config.MetricTemp}
-					m.SettingsSel = metrics[m.SettingsIdx]
+				if m.SettingsIdx < settingsDisplayBase {
+					m.SettingsSel = settingsThresholds[m.SettingsIdx].Metric
 				}
 			case "+", "=", "right", "l":
-				if m.SettingsIdx < 4 {
-					// Threshold adjustment
+				if m.SettingsIdx < settingsDisplayBase {
+					// Threshold adjustment within the metric's range
 					curr := m.Config.Thresholds[m.SettingsSel]
-					if curr < 100 {
+					if curr < settingsThresholds[m.SettingsIdx].Max {
 						m.Config.Thresholds[m.SettingsSel] = curr + 1
 					}
 				} else {
@@ -247,10 +428,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, AddToastCmd("Setting Changed", data.ToastSuccess)
 				}
 			case "-", "_", "left", "h":
-				if m.SettingsIdx < 4 {
+				if m.SettingsIdx < settingsDisplayBase {
 					// Threshold adjustment
 					curr := m.Config.Thresholds[m.SettingsSel]
-					if curr > 0 {
+					if curr > settingsThresholds[m.SettingsIdx].Min {
 						m.Config.Thresholds[m.SettingsSel] = curr - 1
 					}
 				} else {
@@ -273,21 +454,47 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			return m, nil
 		case "space":
-			if m.TreeView && m.SelectedTab == 2 {
-				// Use helper to perform toggle to ensure we target the right logic
-				procs, _ := m.GetVisibleProcesses()
-				if m.SelectedProcess >= 0 && m.SelectedProcess < len(procs) {
-					proc := procs[m.SelectedProcess]
-					m.CollapsedPids[proc.Pid] = !m.CollapsedPids[proc.Pid]
+			if m.currentTab() == "Cgroups" {
+				m.handleCgroupsKey("space")
+			} else if m.SelectedTab == 2 {
+				if group := m.selectedGroup(); group != nil {
+					// Expand or collapse the group under the cursor
+					m.ExpandedGroups[group.Key] = !m.ExpandedGroups[group.Key]
+				} else if m.TreeView {
+					// Use helper to perform toggle to ensure we target the right logic
+					if proc, ok := m.selectedProcess(); ok {
+						m.CollapsedPids[proc.Pid] = !m.CollapsedPids[proc.Pid]
+					}
 				}
 			}
 			return m, nil
 		}
 
 		// Normal key handling
-		currentTab := "Overview"
-		if m.SelectedTab < len(m.ActiveTabs) {
-			currentTab = m.ActiveTabs[m.SelectedTab]
+		currentTab := m.currentTab()
+
+		// Tab-specific keys take precedence over the shared bindings below
+		switch currentTab {
+		case "Containers":
+			if cmd, handled := m.handleContainersKey(msg.String()); handled {
+				return m, cmd
+			}
+		case "Metrics":
+			if cmd, handled := m.handleMetricsKey(msg.String()); handled {
+				return m, cmd
+			}
+		case "Network":
+			if cmd, handled := m.handleNetworkKey(msg.String()); handled {
+				return m, cmd
+			}
+		case "Cgroups":
+			if cmd, handled := m.handleCgroupsKey(msg.String()); handled {
+				return m, cmd
+			}
+		case "Services":
+			if cmd, handled := m.handleServicesKey(msg.String()); handled {
+				return m, cmd
+			}
 		}
 
 		switch msg.String() {
@@ -329,11 +536,25 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Let's re-allocate for now.
 			m.CpuHistory = data.NewRingBuffer(m.HistoryLength)
 			m.MemHistory = data.NewRingBuffer(m.HistoryLength)
-			m.NetHistory = data.NewRingBuffer(m.HistoryLength)
+			m.NetRxHistory = data.NewRingBuffer(m.HistoryLength)
+			m.NetTxHistory = data.NewRingBuffer(m.HistoryLength)
 			m.SwapHistory = data.NewRingBuffer(m.HistoryLength)
 			m.HistoryTemp = data.NewRingBuffer(m.HistoryLength)
 			m.DiskHORead = data.NewRingBuffer(m.HistoryLength)
 			m.DiskHOWrite = data.NewRingBuffer(m.HistoryLength)
+			m.MemBuffersHistory = data.NewRingBuffer(m.HistoryLength)
+			m.MemCachedHistory = data.NewRingBuffer(m.HistoryLength)
+			m.CpuPSIHistory = data.NewRingBuffer(m.HistoryLength)
+			m.MemPSIHistory = data.NewRingBuffer(m.HistoryLength)
+			m.IOPSIHistory = data.NewRingBuffer(m.HistoryLength)
+			// The per-device history maps are recreated, and their buffers
+			// allocated at the new length, by the next sample that fills them
+			m.InterfaceRxHistory = nil
+			m.InterfaceTxHistory = nil
+			m.ProtocolHistory = nil
+			m.SignalHistory = nil
+			m.DiskUtilHistory = nil
+			m.resetProbeHistories()
 		case "C":
 			// Cycle chart type (Metrics tab)
 			switch m.ChartType {
@@ -350,17 +571,23 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			// Update config
 			m.Config.ChartType = m.ChartType
-		case "1":
-			m.SelectedTab = 0
-		case "2":
-			m.SelectedTab = 1
-		case "3":
-			m.SelectedTab = 2
-		case "4":
-			m.SelectedTab = 3
-		case "5":
-			m.SelectedTab = 4
+		case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+			// Jump to a tab by position
+			if idx := int(msg.String()[0] - '1'); idx < len(m.ActiveTabs) {
+				m.SelectedTab = idx
+			}
 		case "S":
+			if currentTab == "Disks" {
+				// Cycle the partition order
+				modes := config.DiskSortModes()
+				for i, mode := range modes {
+					if mode == m.Config.Disks.SortBy {
+						m.Config.Disks.SortBy = modes[(i+1)%len(modes)]
+						break
+					}
+				}
+				return m, system.DiskInfoCmd(m.Config.Disks)
+			}
 			// Cycle Sort Mode
 			if m.SortBy == "cpu" {
 				m.SortBy = "mem"
@@ -381,22 +608,41 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				m.Config.ViewType = viewName
 			}
+		case "b":
+			if currentTab == "Disks" {
+				// Toggle grouping bind mounts of the same filesystem
+				m.Config.Disks.GroupBindMounts = !m.Config.Disks.GroupBindMounts
+				return m, system.DiskInfoCmd(m.Config.Disks)
+			}
+			// Cycle process grouping
+			if currentTab == "Processes" {
+				for i, mode := range data.GroupModes {
+					if mode == m.GroupBy {
+						m.GroupBy = data.GroupModes[(i+1)%len(data.GroupModes)]
+						break
+					}
+				}
+				m.ExpandedGroups = make(map[string]bool)
+				m.SelectedProcess = 0
+				m.ProcessScrollOffset = 0
+				m.Config.GroupBy = m.GroupBy
+			}
 		case "+", "=":
 			if currentTab == "Processes" {
-				filtered := m.GetFilteredProcesses()
-				if m.SelectedProcess < len(filtered) {
-					proc := filtered[m.SelectedProcess]
+				if m.hasBatchTargets() {
+					m.openBatchDialog("renice", -1)
+				} else if proc, ok := m.selectedProcess(); ok {
 					// Decrease delta (increase priority: -1 on Unix, Step Up on Windows logic)
-					return m, process.ReniceProcessCmdSafe(proc.Pid, -1)
+					return m, process.ReniceProcessCmd(proc.Pid, -1)
 				}
 			}
 		case "-", "_":
 			if currentTab == "Processes" {
-				filtered := m.GetFilteredProcesses()
-				if m.SelectedProcess < len(filtered) {
-					proc := filtered[m.SelectedProcess]
+				if m.hasBatchTargets() {
+					m.openBatchDialog("renice", 1)
+				} else if proc, ok := m.selectedProcess(); ok {
 					// Increase delta (decrease priority: +1 on Unix, Step Down on Windows logic)
-					return m, process.ReniceProcessCmdSafe(proc.Pid, 1)
+					return m, process.ReniceProcessCmd(proc.Pid, 1)
 				}
 			}
 		case "o":
@@ -405,9 +651,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if m.ShowOpenFiles {
 					m.ShowOpenFiles = false
 				} else {
-					filtered := m.GetFilteredProcesses()
-					if m.SelectedProcess < len(filtered) {
-						proc := filtered[m.SelectedProcess]
+					if proc, ok := m.selectedProcess(); ok {
 						m.ShowOpenFiles = true
 						m.OpenFilesList = nil // Clear previous
 						m.OpenFilesPid = proc.Pid
@@ -418,20 +662,107 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		case "z":
 			if currentTab == "Processes" {
-				filtered := m.GetFilteredProcesses()
-				if m.SelectedProcess < len(filtered) {
-					proc := filtered[m.SelectedProcess]
+				if m.hasBatchTargets() {
+					m.openBatchDialog("suspend", 0)
+				} else if proc, ok := m.selectedProcess(); ok {
 					return m, process.SuspendProcessCmd(proc.Pid)
 				}
 			}
 		case "x":
 			if currentTab == "Processes" {
-				filtered := m.GetFilteredProcesses()
-				if m.SelectedProcess < len(filtered) {
-					proc := filtered[m.SelectedProcess]
+				if m.hasBatchTargets() {
+					m.openBatchDialog("resume", 0)
+				} else if proc, ok := m.selectedProcess(); ok {
 					return m, process.ResumeProcessCmd(proc.Pid)
 				}
 			}
+		case "I":
+			// Edit nice, I/O priority and CPU affinity
+			if currentTab == "Processes" {
+				if proc, ok := m.selectedProcess(); ok {
+					m.ShowSchedDialog = true
+					m.SchedLoading = true
+					m.SchedPid = proc.Pid
+					m.SchedName = proc.Name
+					return m, process.FetchSchedCmd(proc.Pid)
+				}
+			}
+		case "e":
+			// Show process start/exit events
+			if currentTab == "Processes" {
+				m.ShowEvents = true
+				m.EventsScrollOffset = 0
+			}
+		case "s":
+			// Send a signal to the marked processes (or the selected one)
+			if currentTab == "Processes" {
+				m.openBatchDialog("signal", 0)
+			}
+		case "m":
+			// Toggle mark on the selected process (or every member of the
+			// selected group) and advance
+			if currentTab == "Processes" {
+				var targets []data.ProcessInfo
+				if group := m.selectedGroup(); group != nil {
+					targets = group.Members
+				} else if proc, ok := m.selectedProcess(); ok {
+					targets = []data.ProcessInfo{proc}
+				}
+				if len(targets) > 0 {
+					allMarked := true
+					for _, proc := range targets {
+						allMarked = allMarked && m.MarkedPids[proc.Pid]
+					}
+					for _, proc := range targets {
+						if allMarked {
+							delete(m.MarkedPids, proc.Pid)
+						} else {
+							m.MarkedPids[proc.Pid] = true
+						}
+					}
+					visibleProcs, _ := m.GetVisibleProcesses()
+					if m.SelectedProcess < len(visibleProcs)-1 {
+						m.SelectedProcess++
+						visibleRows := m.getVisibleProcessRows()
+						if m.SelectedProcess >= m.ProcessScrollOffset+visibleRows {
+							m.ProcessScrollOffset = m.SelectedProcess - visibleRows + 1
+						}
+					}
+				}
+			}
+		case "M":
+			// Mark the selected process and its whole subtree
+			if currentTab == "Processes" {
+				if proc, ok := m.selectedProcess(); ok {
+					for _, pid := range m.GetSubtreePids(proc.Pid) {
+						m.MarkedPids[pid] = true
+					}
+				}
+			}
+		case "a":
+			// Mark every process matching the filter (unmark if all are marked)
+			if currentTab == "Processes" {
+				filtered := m.GetFilteredProcesses()
+				allMarked := len(filtered) > 0
+				for _, proc := range filtered {
+					if !m.MarkedPids[proc.Pid] {
+						allMarked = false
+						break
+					}
+				}
+				for _, proc := range filtered {
+					if allMarked {
+						delete(m.MarkedPids, proc.Pid)
+					} else {
+						m.MarkedPids[proc.Pid] = true
+					}
+				}
+			}
+		case "u":
+			// Clear all marks
+			if currentTab == "Processes" {
+				m.MarkedPids = make(map[int32]bool)
+			}
 		case "p":
 			m.Paused = !m.Paused
 		case "r":
@@ -440,7 +771,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				system.MetricsCmd(),
 				process.ProcessesCmd(m.SortBy),
 				system.HostInfoCmd(),
-				system.DiskInfoCmd(),
+				system.DiskInfoCmd(m.Config.Disks),
 				system.GpuInfoCmd(),
 			)
 		case "?":
@@ -478,9 +809,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "K":
 			// Kill process (capital K)
 			if currentTab == "Processes" && len(m.Processes) > 0 {
-				filtered := m.GetFilteredProcesses()
-				if m.SelectedProcess < len(filtered) {
-					proc := filtered[m.SelectedProcess]
+				if m.hasBatchTargets() {
+					m.openBatchDialog("kill", 0)
+				} else if proc, ok := m.selectedProcess(); ok {
 					m.ShowKillDialog = true
 					m.KillTargetPid = proc.Pid
 					m.KillTargetName = proc.Name
@@ -490,11 +821,30 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Toggle filter mode
 			if currentTab == "Processes" {
 				m.FilterMode = true
+				m.FilterHistoryIdx = -1
+			}
+		case "F":
+			// Cycle through saved filters from the config
+			if currentTab == "Processes" && len(m.Config.SavedFilters) > 0 {
+				names := make([]string, 0, len(m.Config.SavedFilters))
+				for name := range m.Config.SavedFilters {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+				m.SavedFilterIdx = (m.SavedFilterIdx + 1) % len(names)
+				name := names[m.SavedFilterIdx]
+				m.SetProcessFilter(m.Config.SavedFilters[name])
+				m.SelectedProcess = 0
+				m.ProcessScrollOffset = 0
+				if m.FilterError != "" {
+					return m, AddToastCmd(fmt.Sprintf("Filter %q: %s", name, m.FilterError), data.ToastError)
+				}
+				return m, AddToastCmd(fmt.Sprintf("Filter: %s", name), data.ToastInfo)
 			}
 		case "c":
 			// Clear filter
 			if currentTab == "Processes" {
-				m.ProcessFilter = ""
+				m.SetProcessFilter("")
 				m.SelectedProcess = 0
 				m.ProcessScrollOffset = 0
 			}
@@ -507,7 +857,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "G":
 			// Go to bottom
 			if currentTab == "Processes" {
-				filteredLen := m.getFilteredProcessCount()
+				filteredLen := m.getVisibleProcessCount()
 				if filteredLen > 0 {
 					m.SelectedProcess = filteredLen - 1
 					visibleRows := m.getVisibleProcessRows()
@@ -529,26 +879,91 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			system.TickCmd(time.Duration(m.RefreshRate) * time.Millisecond),
 		}
 
-		// Always update fast metrics (CPU/Mem)
-		cmds = append(cmds, system.FastMetricsCmd())
+		// Always update fast metrics (CPU/Mem/PSI/network and protocol rates) and process start/exit events
+		cmds = append(cmds, system.FastMetricsCmd(), system.PressureCmd(), system.NetworkInterfacesCmd(), system.ProtocolStatsCmd(), process.ProcessEventsCmd(m.Config.EventLogPath))
 
-		// Update Process List, Network, Disk IO every 2nd tick (2s)
+		// Update Process List, Disk IO and wireless links every 2nd tick (2s)
 		if m.TickCount%2 == 0 {
 			cmds = append(cmds,
 				process.ProcessesCmd(m.SortBy),
 				system.DiskIOCmd(),
-				system.NetworkInterfacesCmd(),
 				system.TempCmd(),
+				system.WirelessCmd(),
 			)
 		}
 
-		// Update Slow Metrics (Disk Usage/Net Totals) every 5th tick (5s)
+		// Poll the container API with stats every 2nd tick while the Containers tab is on screen.
+		// Stats requests take about a second, so skip a poll that is still running.
+		// Otherwise refresh only the names the process list shows, every 10th tick.
+		if m.TickCount%2 == 0 && !m.ContainersLoading && m.currentTab() == "Containers" {
+			m.ContainersLoading = true
+			cmds = append(cmds, container.ListCmd(m.Config.DockerSocket))
+		} else if m.TickCount%10 == 0 && m.hasTab("Containers") && m.currentTab() != "Containers" {
+			cmds = append(cmds, container.NamesCmd(m.Config.DockerSocket))
+		}
+
+		// Paging rates and zswap/zram for the Memory tab
+		if m.hasTab("Memory") {
+			cmds = append(cmds, system.MemoryDetailCmd())
+		}
+
+		// Sockets every 2nd tick while the Connections view is on screen
+		if m.TickCount%2 == 0 && m.currentTab() == "Network" {
+			if cmd := m.refreshNetworkView(); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+
+		// TCP socket counters for the NET columns, alongside the process list
+		if m.TickCount%2 == 0 && !m.SocketTrafficLoading && m.wantsSocketTraffic() {
+			m.SocketTrafficLoading = true
+			cmds = append(cmds, netstat.SocketTrafficCmd())
+		}
+
+		// Endpoint probes on their own intervals, whatever is on screen, so
+		// their alerts keep working
+		cmds = append(cmds, m.runProbes(false)...)
+
+		// Per-node memory and numastat change; the layout itself does not
+		if m.TickCount%5 == 0 && m.currentTab() == "System" {
+			cmds = append(cmds, system.TopologyCmd())
+		}
+
+		// Link state and addresses while the interface cards are on screen, and
+		// less often elsewhere when the charts are scaled to the link speed
+		cardsShown := m.currentTab() == "Network" && m.NetworkView == ""
+		if (cardsShown && m.TickCount%5 == 0) || (!cardsShown && m.Config.NetScale == "link" && m.TickCount%10 == 0) {
+			cmds = append(cmds, system.InterfaceDetailsCmd())
+		}
+
+		// Interrupt counters only while the interrupts view is on screen
+		if m.currentTab() == "Metrics" && m.MetricsView == "interrupts" {
+			cmds = append(cmds, system.InterruptsCmd())
+		}
+
+		// Walk the cgroup tree every 2nd tick, only while it is on screen
+		if m.TickCount%2 == 0 && !m.CgroupsLoading && m.currentTab() == "Cgroups" {
+			m.CgroupsLoading = true
+			cmds = append(cmds, cgroup.TreeCmd())
+		}
+
+		// Likewise for services, and follow the open journal
+		if m.TickCount%2 == 0 && !m.ServicesLoading && m.currentTab() == "Services" {
+			m.ServicesLoading = true
+			cmds = append(cmds, services.ListCmd(m.serviceManager))
+		}
+		if m.TickCount%2 == 0 && m.ShowJournal && !m.JournalLoading {
+			m.JournalLoading = true
+			cmds = append(cmds, services.JournalCmd(m.serviceManager, m.JournalUnit))
+		}
+
+		// Update Slow Metrics (Disk Usage) every 5th tick (5s)
 		if m.TickCount%5 == 0 {
 			cmds = append(cmds,
 				system.SlowMetricsCmd(),
 				system.BatteryCmd(),
 				system.HostInfoCmd(),
-				system.DiskInfoCmd(),
+				system.DiskInfoCmd(m.Config.Disks),
 			)
 		}
 
@@ -562,35 +977,89 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.LoadAvg = msg.LoadAvg
 		m.MemInfo = msg.MemInfo   // Cache for render
 		m.SwapInfo = msg.SwapInfo // Cache for render
+		m.CoreFreqs = msg.CoreFreqs
+
+		// Per-core breakdown from the delta against the previous sample
+		if len(msg.CpuTimes) > 0 && len(msg.CpuTimes) == len(m.LastCpuTimes) {
+			breakdown := make([]data.CoreTimes, len(msg.CpuTimes))
+			for i := range msg.CpuTimes {
+				breakdown[i] = coreTimesBetween(m.LastCpuTimes[i], msg.CpuTimes[i])
+			}
+			m.CoreTimes = breakdown
+		}
+		m.LastCpuTimes = msg.CpuTimes
 
 		m.CpuHistory.Push(m.Cpu)
 		m.MemHistory.Push(m.Memory)
 		m.SwapHistory.Push(m.Swap)
-
-	case messages.DiskNetMsg:
-		if m.LastNetSent > 0 && m.LastNetRecv > 0 {
-			m.NetSentRate = float64(msg.NetSent-m.LastNetSent) / 1024 / 1024
-			m.NetRecvRate = float64(msg.NetRecv-m.LastNetRecv) / 1024 / 1024
+		// The stacked memory chart needs the three series in step
+		buffers, cached := 0.0, 0.0
+		if msg.MemInfo != nil && msg.MemInfo.Total > 0 {
+			buffers = float64(msg.MemInfo.Buffers) / float64(msg.MemInfo.Total) * 100
+			cached = float64(msg.MemInfo.Cached) / float64(msg.MemInfo.Total) * 100
 		}
-		// Fallback for huge jumps (first run)
-		if m.NetSentRate < 0 {
-			m.NetSentRate = 0
+		m.MemBuffersHistory.Push(buffers)
+		m.MemCachedHistory.Push(cached)
+
+	case messages.MemoryDetailMsg:
+		detail := data.MemoryDetail(msg)
+		if last := m.MemDetail; last.Available && detail.Available {
+			if elapsed := detail.Time.Sub(last.Time).Seconds(); elapsed > 0 {
+				rate := func(cur, prev uint64) float64 {
+					if cur < prev {
+						return 0
+					}
+					return float64(cur-prev) / elapsed
+				}
+				detail.PageFaultRate = rate(detail.PageFaults, last.PageFaults)
+				detail.MajorFaultRate = rate(detail.MajorFaults, last.MajorFaults)
+				detail.SwapInRate = rate(detail.SwapInBytes, last.SwapInBytes)
+				detail.SwapOutRate = rate(detail.SwapOutBytes, last.SwapOutBytes)
+			}
 		}
-		if m.NetRecvRate < 0 {
-			m.NetRecvRate = 0
+		m.MemDetail = detail
+
+	case messages.TopologyMsg:
+		m.Topology = data.CPUTopology(msg)
+
+	case messages.InterruptsMsg:
+		m.updateInterrupts(data.InterruptInfo(msg))
+
+	case messages.PressureMsg:
+		m.Pressure = data.PressureInfo(msg)
+		if m.Pressure.Available {
+			m.CpuPSIHistory.Push(m.Pressure.CPU.Some.Avg10)
+			m.MemPSIHistory.Push(m.Pressure.Memory.Some.Avg10)
+			m.IOPSIHistory.Push(m.Pressure.IO.Some.Avg10)
 		}
 
-		m.LastNetSent = msg.NetSent
-		m.LastNetRecv = msg.NetRecv
+	case messages.ProbeResultMsg:
+		m.updateProbe(msg)
+
+	case messages.ProtocolStatsMsg:
+		m.updateProtocolStats(data.ProtocolStats(msg))
+
+	case messages.DiskNetMsg:
 		m.Disk = msg.Disk
 
-		totalNetRate := m.NetSentRate + m.NetRecvRate
-		netPercent := (totalNetRate / 10) * 100 // Arbitrary 10MB/s cap for graph scaling logic
-		if netPercent > 100 {
-			netPercent = 100
+	case messages.ProcessEventsMsg:
+		m.ProcessEvents = append(m.ProcessEvents, msg.Events...)
+		if len(m.ProcessEvents) > data.MaxProcessEvents {
+			m.ProcessEvents = m.ProcessEvents[len(m.ProcessEvents)-data.MaxProcessEvents:]
+		}
+		// Keep the view anchored while new events arrive below
+		if m.EventsScrollOffset > 0 {
+			m.EventsScrollOffset += len(msg.Events)
+		}
+		if msg.Err != nil {
+			// Only report a log failure once until it changes
+			if msg.Err.Error() != m.EventLogError {
+				m.EventLogError = msg.Err.Error()
+				return m, AddToastCmd(fmt.Sprintf("Event Log Error: %v", msg.Err), data.ToastError)
+			}
+		} else if len(msg.Events) > 0 {
+			m.EventLogError = ""
 		}
-
-		m.NetHistory.Push(netPercent)
 
 	case messages.ProcessesMsg:
 		// Processes are sorted in background by ProcessesCmd
@@ -602,9 +1071,23 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			allProcesses = allProcesses[:maxProcesses]
 		}
 		m.Processes = allProcesses
+		m.applyNetRates()
+
+		// Drop marks for processes that have exited
+		if len(m.MarkedPids) > 0 {
+			running := make(map[int32]bool, len(allProcesses))
+			for _, p := range allProcesses {
+				running[p.Pid] = true
+			}
+			for pid := range m.MarkedPids {
+				if !running[pid] {
+					delete(m.MarkedPids, pid)
+				}
+			}
+		}
 
 		// Clamp selection
-		filteredLen := m.getFilteredProcessCount()
+		filteredLen := m.getVisibleProcessCount()
 		if m.SelectedProcess >= filteredLen {
 			m.SelectedProcess = filteredLen - 1
 			if m.SelectedProcess < 0 {
@@ -612,42 +1095,193 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
-	case messages.HostInfoMsg:
-		m.HostInfo = msg
-	case messages.DiskInfoMsg:
-		m.DiskPartitions = msg
-	case messages.GpuInfoMsg:
-		m.GpuInfo = msg
-	case messages.DiskIOMsg:
-		// Calculate rates
-		if len(m.LastDiskIO) > 0 {
-			var totalRead, totalWrite uint64
-			var lastTotalRead, lastTotalWrite uint64
+		// Refresh cgroup accounting for the containers seen in this sample
+		if refs := container.RefsFromProcesses(allProcesses); len(refs) > 0 {
+			return m, container.StatsCmd(refs)
+		}
+		m.ContainerStats = nil
+
+	case messages.ContainersMsg:
+		m.ContainersLoading = false
+		if msg.Err != nil {
+			m.ContainersErr = msg.Err.Error()
+			m.Containers = nil
+			return m, nil
+		}
+		m.ContainersErr = ""
+		m.Containers = msg.Containers
+		if m.Containers == nil {
+			m.Containers = []data.Container{}
+		}
+		if m.SelectedContainer >= len(m.Containers) {
+			m.SelectedContainer = len(m.Containers) - 1
+			if m.SelectedContainer < 0 {
+				m.SelectedContainer = 0
+			}
+		}
+
+	case messages.ContainerActionMsg:
+		if msg.Err != nil {
+			return m, AddToastCmd(fmt.Sprintf("%s %s failed: %v", strings.Title(msg.Action), msg.Name, msg.Err), data.ToastError)
+		}
+		cmds := []tea.Cmd{AddToastCmd(fmt.Sprintf("%s: %s", strings.Title(msg.Action), msg.Name), data.ToastSuccess)}
+		if !m.ContainersLoading {
+			m.ContainersLoading = true
+			cmds = append(cmds, container.ListCmd(m.Config.DockerSocket))
+		}
+		return m, tea.Batch(cmds...)
 
-			// Sum up all partitions/disks
-			for k, v := range msg {
-				totalRead += v.ReadBytes
-				totalWrite += v.WriteBytes
-				if last, ok := m.LastDiskIO[k]; ok {
-					lastTotalRead += last.ReadBytes
-					lastTotalWrite += last.WriteBytes
+	case messages.ContainerStatsMsg:
+		// Calculate rates against the previous sample
+		for id, st := range msg {
+			if last, ok := m.ContainerStats[id]; ok {
+				elapsed := st.Time.Sub(last.Time).Seconds()
+				if elapsed > 0 && st.CPUUsageUsec >= last.CPUUsageUsec {
+					st.CpuPercent = float64(st.CPUUsageUsec-last.CPUUsageUsec) / (elapsed * 1e6) * 100
 				}
+				if elapsed > 0 && st.IOReadBytes >= last.IOReadBytes && st.IOWriteBytes >= last.IOWriteBytes {
+					st.IOReadRate = float64(st.IOReadBytes-last.IOReadBytes) / elapsed
+					st.IOWriteRate = float64(st.IOWriteBytes-last.IOWriteBytes) / elapsed
+				}
+			}
+			msg[id] = st
+		}
+		m.ContainerStats = msg
+
+	case messages.CgroupsMsg:
+		m.CgroupsLoading = false
+		if msg.Err != nil {
+			m.CgroupsErr = msg.Err.Error()
+			m.Cgroups = nil
+			return m, nil
+		}
+		m.CgroupsErr = ""
+
+		// Calculate rates against the previous walk
+		last := make(map[string]data.CgroupNode, len(m.Cgroups))
+		for _, node := range m.Cgroups {
+			last[node.Path] = node
+		}
+		for i := range msg.Nodes {
+			node := &msg.Nodes[i]
+			prev, ok := last[node.Path]
+			if !ok {
+				continue
 			}
+			elapsed := node.Time.Sub(prev.Time).Seconds()
+			if elapsed > 0 && node.CPUUsageUsec >= prev.CPUUsageUsec {
+				node.CpuPercent = float64(node.CPUUsageUsec-prev.CPUUsageUsec) / (elapsed * 1e6) * 100
+			}
+			if elapsed > 0 && node.IOReadBytes >= prev.IOReadBytes && node.IOWriteBytes >= prev.IOWriteBytes {
+				node.IOReadRate = float64(node.IOReadBytes-prev.IOReadBytes) / elapsed
+				node.IOWriteRate = float64(node.IOWriteBytes-prev.IOWriteBytes) / elapsed
+			}
+		}
+		m.Cgroups = msg.Nodes
+
+		if visible := len(m.GetVisibleCgroups()); m.SelectedCgroup >= visible {
+			m.SelectedCgroup = visible - 1
+			if m.SelectedCgroup < 0 {
+				m.SelectedCgroup = 0
+			}
+		}
 
-			// Calculate MB/s (assuming 1 second interval)
-			if totalRead >= lastTotalRead {
-				m.DiskReadRate = float64(totalRead-lastTotalRead) / 1024 / 1024
+	case messages.ConnectionsMsg:
+		m.ConnectionsLoading = false
+		if msg.Err != nil {
+			m.ConnectionsErr = msg.Err.Error()
+			m.Connections = nil
+			return m, nil
+		}
+		m.ConnectionsErr = ""
+		m.Connections = msg.Connections
+		m.applyNetRates()
+		if n := len(m.GetFilteredConnections()); m.SelectedConnection >= n {
+			m.SelectedConnection = n - 1
+			if m.SelectedConnection < 0 {
+				m.SelectedConnection = 0
+			}
+		}
+
+	case messages.SocketTrafficMsg:
+		m.SocketTrafficLoading = false
+		m.updateSocketTraffic(data.SocketTraffic(msg))
+
+	case messages.ServicesMsg:
+		m.ServicesLoading = false
+		if msg.Err != nil {
+			m.ServicesErr = msg.Err.Error()
+			m.Services = nil
+			return m, nil
+		}
+		m.ServicesErr = ""
+
+		// Calculate CPU usage against the previous sample
+		last := make(map[string]data.Service, len(m.Services))
+		for _, svc := range m.Services {
+			last[svc.Unit] = svc
+		}
+		for i := range msg.Services {
+			svc := &msg.Services[i]
+			if prev, ok := last[svc.Unit]; ok {
+				elapsed := svc.Time.Sub(prev.Time).Seconds()
+				if elapsed > 0 && svc.CPUUsageUsec >= prev.CPUUsageUsec {
+					svc.CpuPercent = float64(svc.CPUUsageUsec-prev.CPUUsageUsec) / (elapsed * 1e6) * 100
+				}
 			}
-			if totalWrite >= lastTotalWrite {
-				m.DiskWriteRate = float64(totalWrite-lastTotalWrite) / 1024 / 1024
+		}
+		m.Services = msg.Services
+		if m.SelectedService >= len(m.Services) {
+			m.SelectedService = len(m.Services) - 1
+			if m.SelectedService < 0 {
+				m.SelectedService = 0
 			}
+		}
+
+	case messages.ServiceActionMsg:
+		if msg.Err != nil {
+			return m, AddToastCmd(fmt.Sprintf("%s %s failed: %v", strings.Title(msg.Action), msg.Unit, msg.Err), data.ToastError)
+		}
+		cmds := []tea.Cmd{AddToastCmd(fmt.Sprintf("%s: %s", strings.Title(msg.Action), msg.Unit), data.ToastSuccess)}
+		if !m.ServicesLoading {
+			m.ServicesLoading = true
+			cmds = append(cmds, services.ListCmd(m.serviceManager))
+		}
+		return m, tea.Batch(cmds...)
 
-			// Update history
-			m.DiskHORead.Push(m.DiskReadRate)
-			m.DiskHOWrite.Push(m.DiskWriteRate)
+	case messages.JournalMsg:
+		m.JournalLoading = false
+		if !m.ShowJournal || msg.Unit != m.JournalUnit {
+			return m, nil
 		}
-		m.DiskIO = msg
-		m.LastDiskIO = msg
+		if msg.Err != nil {
+			m.JournalView.SetContent("Cannot read the journal: " + msg.Err.Error())
+			return m, nil
+		}
+		lines := msg.Lines
+		if len(lines) == 0 {
+			lines = []string{"No journal entries for " + msg.Unit}
+		}
+
+		// Follow new lines when scrolled to the end, otherwise keep the position
+		m.JournalView.Height = m.getJournalRows()
+		offset := m.JournalView.YOffset
+		following := len(m.JournalView.Content) <= m.JournalView.Height || offset >= len(m.JournalView.Content)-m.JournalView.Height
+		m.JournalView.SetContent(strings.Join(lines, "\n"))
+		if following {
+			m.JournalView.GotoBottom()
+		} else {
+			m.JournalView.LineDown(offset)
+		}
+
+	case messages.HostInfoMsg:
+		m.HostInfo = msg
+	case messages.DiskInfoMsg:
+		m.DiskPartitions = msg
+	case messages.GpuInfoMsg:
+		m.GpuInfo = msg
+	case messages.DiskIOMsg:
+		m.updateDiskIO(msg)
 	case messages.TempMsg:
 		m.Sensors = msg
 		// Calculate CPU temp (average of coretemp or k10temp)
@@ -672,19 +1306,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.HistoryTemp.Push(m.CpuTemp)
 
 	case messages.NetworkInterfacesMsg:
-		m.NetworkInterfaces = msg
-		if m.LastNetworkInterfaces == nil {
-			m.LastNetworkInterfaces = make(map[string]net.IOCountersStat)
-		}
+		m.updateInterfaceRates(msg)
 
-		// Update map for next rate calculation
-		// Note: We don't really need to store rates in Model unless we want to graph them per interface.
-		// For now, let's just store the current counters into LastNetworkInterfaces after render?
-		// Actually, standard way is to update LastNetworkInterfaces here.
-		for _, nic := range msg {
-			m.LastNetworkInterfaces[nic.Name] = nic
+	case messages.InterfaceDetailsMsg:
+		m.InterfaceDetails = make(map[string]data.InterfaceInfo, len(msg))
+		for _, info := range msg {
+			m.InterfaceDetails[info.Name] = info
 		}
 
+	case messages.WirelessMsg:
+		m.updateWireless(msg)
+
 	case messages.BatteryMsg:
 		m.Battery = msg
 	}
@@ -705,9 +1337,190 @@ func TickToastCmd(id int64, duration time.Duration) tea.Cmd {
 	})
 }
 
-// getFilteredProcessCount returns the count of filtered processes
-func (m Model) getFilteredProcessCount() int {
-	return len(m.GetFilteredProcesses())
+// handleSchedKey handles keys while the scheduling dialog is open
+func (m Model) handleSchedKey(key string) (tea.Model, tea.Cmd) {
+	if key == "esc" || key == "I" {
+		m.ShowSchedDialog = false
+		return m, nil
+	}
+	if m.SchedLoading {
+		return m, nil
+	}
+
+	info := &m.SchedInfo
+	// Fields that can be focused, skipping unsupported controls
+	fields := []int{0}
+	if info.IOSupported {
+		fields = append(fields, 1, 2)
+	}
+	if info.AffinitySupported && len(info.Affinity) > 0 {
+		fields = append(fields, 3)
+	}
+	pos := 0
+	for i, f := range fields {
+		if f == m.SchedField {
+			pos = i
+		}
+	}
+
+	switch key {
+	case "enter":
+		m.ShowSchedDialog = false
+		return m, process.ApplySchedCmd(m.SchedPid, m.SchedOriginal, m.SchedInfo)
+	case "up", "k":
+		m.SchedField = fields[(pos-1+len(fields))%len(fields)]
+	case "down", "j", "tab":
+		m.SchedField = fields[(pos+1)%len(fields)]
+	case "left", "h", "right", "l":
+		dir := 1
+		if key == "left" || key == "h" {
+			dir = -1
+		}
+		switch m.SchedField {
+		case 0:
+			info.Nice += dir
+			if info.Nice < -20 {
+				info.Nice = -20
+			}
+			if info.Nice > 19 {
+				info.Nice = 19
+			}
+		case 1:
+			info.IOClass = (info.IOClass + dir + len(data.IOClassNames)) % len(data.IOClassNames)
+		case 2:
+			info.IOLevel += dir
+			if info.IOLevel < 0 {
+				info.IOLevel = 0
+			}
+			if info.IOLevel > 7 {
+				info.IOLevel = 7
+			}
+		case 3:
+			n := len(info.Affinity)
+			m.SchedCpuCursor = (m.SchedCpuCursor + dir + n) % n
+		}
+	case "space":
+		if m.SchedField == 3 && m.SchedCpuCursor < len(info.Affinity) {
+			info.Affinity[m.SchedCpuCursor] = !info.Affinity[m.SchedCpuCursor]
+		}
+	case "a":
+		if m.SchedField == 3 {
+			// Allow every CPU, or only the cursor CPU if all are already allowed
+			all := true
+			for _, allowed := range info.Affinity {
+				all = all && allowed
+			}
+			for i := range info.Affinity {
+				info.Affinity[i] = !all || i == m.SchedCpuCursor
+			}
+		}
+	}
+	return m, nil
+}
+
+// pushFilterHistory records an applied filter, most recent first
+func (m *Model) pushFilterHistory(filter string) {
+	if filter == "" {
+		return
+	}
+	history := []string{filter}
+	for _, h := range m.Config.FilterHistory {
+		if h != filter {
+			history = append(history, h)
+		}
+	}
+	if len(history) > config.MaxFilterHistory {
+		history = history[:config.MaxFilterHistory]
+	}
+	m.Config.FilterHistory = history
+}
+
+// selectedProcess returns the process under the cursor in the visible list.
+// Group rows are not processes, so it reports false for them.
+func (m Model) selectedProcess() (data.ProcessInfo, bool) {
+	procs, _ := m.GetVisibleProcesses()
+	if m.SelectedProcess >= 0 && m.SelectedProcess < len(procs) && procs[m.SelectedProcess].Group == nil {
+		return procs[m.SelectedProcess], true
+	}
+	return data.ProcessInfo{}, false
+}
+
+// selectedGroup returns the group under the cursor, or nil when the cursor
+// is on a process row
+func (m Model) selectedGroup() *data.ProcessGroup {
+	procs, _ := m.GetVisibleProcesses()
+	if m.SelectedProcess >= 0 && m.SelectedProcess < len(procs) {
+		return procs[m.SelectedProcess].Group
+	}
+	return nil
+}
+
+// hasBatchTargets reports whether an action should go through the batch
+// dialog: processes are marked or the cursor is on a group row
+func (m Model) hasBatchTargets() bool {
+	return len(m.MarkedPids) > 0 || m.selectedGroup() != nil
+}
+
+// openBatchDialog asks for confirmation before applying action to the
+// marked processes, the members of the selected group, or the selected
+// process when nothing is marked
+func (m *Model) openBatchDialog(action string, delta int) {
+	targets := m.GetMarkedProcesses()
+	if len(targets) == 0 {
+		if group := m.selectedGroup(); group != nil {
+			targets = group.Members
+		} else if proc, ok := m.selectedProcess(); ok {
+			targets = []data.ProcessInfo{proc}
+		}
+	}
+	if len(targets) == 0 {
+		return
+	}
+	m.ShowBatchDialog = true
+	m.BatchAction = action
+	m.BatchDelta = delta
+	m.BatchTargets = targets
+	if m.BatchSignal == "" {
+		m.BatchSignal = data.SignalNames[0]
+	}
+}
+
+// getVisibleProcessCount returns the number of rows in the process list
+func (m Model) getVisibleProcessCount() int {
+	procs, _ := m.GetVisibleProcesses()
+	return len(procs)
+}
+
+// getEventsPanelRows returns how many events fit in the Events panel.
+// Keep in sync with RenderEventsOverlay.
+func (m Model) getEventsPanelRows() int {
+	boxHeight := 24
+	if boxHeight > m.Height-4 {
+		boxHeight = m.Height - 4
+	}
+	rows := boxHeight - 6
+	if rows < 3 {
+		rows = 3
+	}
+	return rows
+}
+
+// currentTab returns the name of the selected tab
+func (m Model) currentTab() string {
+	if m.SelectedTab < len(m.ActiveTabs) {
+		return m.ActiveTabs[m.SelectedTab]
+	}
+	return "Overview"
+}
+
+// hasTab reports whether a tab is enabled
+func (m Model) hasTab(name string) bool {
+	for _, tab := range m.ActiveTabs {
+		if tab == name {
+			return true
+		}
+	}
+	return false
 }
 
 // getVisibleProcessRows returns how many process rows can be displayed
@@ -721,7 +1534,7 @@ func (m Model) getVisibleProcessRows() int {
 
 // Helper to sort tabs according to standard order
 func sortActiveTabs(active []string) []string {
-	standard := []string{"Overview", "Metrics", "Processes", "Disks", "Network", "System"}
+	standard := config.AllTabs()
 	var sorted []string
 	for _, std := range standard {
 		for _, act := range active {
@@ -733,11 +1546,23 @@ func sortActiveTabs(active []string) []string {
 	return sorted
 }
 
+// settingsThresholds are the alert thresholds editable in the settings
+// overlay; the overlay lists the same slice in the same order
+var settingsThresholds = config.SettingsThresholds()
+
+var (
+	// settingsDisplayBase is the settings index of the first display
+	// setting, after the thresholds
+	settingsDisplayBase = len(settingsThresholds)
+	// settingsTabsBase is the settings index of the first tab toggle
+	settingsTabsBase = settingsDisplayBase + 4
+)
+
 // handleSettingsChange handles non-threshold settings updates
 // dir: 1 for forward (Right/K/...), -1 for backward (Left/J/...)
 func (m *Model) handleSettingsChange(dir int) {
 	switch m.SettingsIdx {
-	case 4: // Chart Type
+	case settingsDisplayBase: // Chart Type
 		types := []string{"sparkline", "line", "bar", "braille", "tty"}
 		for i, t := range types {
 			if t == m.ChartType {
@@ -748,7 +1573,7 @@ func (m *Model) handleSettingsChange(dir int) {
 		}
 		m.Config.ChartType = m.ChartType
 
-	case 5: // View Type
+	case settingsDisplayBase + 1: // View Type
 		m.TreeView = !m.TreeView
 		viewName := "normal"
 		if m.TreeView {
@@ -756,7 +1581,7 @@ func (m *Model) handleSettingsChange(dir int) {
 		}
 		m.Config.ViewType = viewName
 
-	case 6: // Sort By
+	case settingsDisplayBase + 2: // Sort By
 		opts := []string{"cpu", "mem", "pid"}
 		for i, o := range opts {
 			if o == m.SortBy {
@@ -767,7 +1592,7 @@ func (m *Model) handleSettingsChange(dir int) {
 		}
 		m.Config.SortBy = m.SortBy
 
-	case 7: // History Length
+	case settingsDisplayBase + 3: // History Length
 		lens := []int{60, 300, 900, 3600}
 		for i, l := range lens {
 			if l == m.HistoryLength {
@@ -777,34 +1602,38 @@ func (m *Model) handleSettingsChange(dir int) {
 			}
 		}
 		m.Config.HistoryLength = m.HistoryLength
+	}
 
-	case 8, 9, 10, 11, 12, 13: // Tabs
-		allTabs := []string{"Overview", "Metrics", "Processes", "Disks", "Network", "System"}
-		tabIdx := m.SettingsIdx - 8
-		if tabIdx >= 0 && tabIdx < len(allTabs) {
-			targetTab := allTabs[tabIdx]
+	// Tabs and appearance settings follow the display settings
+	allTabs := config.AllTabs()
+	appearanceBase := settingsTabsBase + len(allTabs)
 
-			// Check if active
-			idxInActive := -1
-			for i, t := range m.ActiveTabs {
-				if t == targetTab {
-					idxInActive = i
-					break
-				}
-			}
+	if m.SettingsIdx >= settingsTabsBase && m.SettingsIdx < appearanceBase { // Tabs
+		targetTab := allTabs[m.SettingsIdx-settingsTabsBase]
 
-			if idxInActive >= 0 {
-				// Remove
-				m.ActiveTabs = append(m.ActiveTabs[:idxInActive], m.ActiveTabs[idxInActive+1:]...)
-			} else {
-				// Add
-				m.ActiveTabs = append(m.ActiveTabs, targetTab)
-				m.ActiveTabs = sortActiveTabs(m.ActiveTabs)
+		// Check if active
+		idxInActive := -1
+		for i, t := range m.ActiveTabs {
+			if t == targetTab {
+				idxInActive = i
+				break
 			}
-			m.Config.Tabs = m.ActiveTabs
 		}
 
-	case 14: // Theme
+		if idxInActive >= 0 {
+			// Remove
+			m.ActiveTabs = append(m.ActiveTabs[:idxInActive], m.ActiveTabs[idxInActive+1:]...)
+		} else {
+			// Add
+			m.ActiveTabs = append(m.ActiveTabs, targetTab)
+			m.ActiveTabs = sortActiveTabs(m.ActiveTabs)
+		}
+		m.Config.Tabs = m.ActiveTabs
+		return
+	}
+
+	switch m.SettingsIdx {
+	case appearanceBase: // Theme
 		themes := config.GetThemeNames()
 		for i, t := range themes {
 			if t == m.Theme {
@@ -818,7 +1647,7 @@ func (m *Model) handleSettingsChange(dir int) {
 			m.Config.CustomTheme = config.DefaultCustomTheme()
 		}
 
-	case 15: // Refresh Rate
+	case appearanceBase + 1: // Refresh Rate
 		rates := config.GetRefreshRates()
 		for i, r := range rates {
 			if r == m.RefreshRate {
@@ -829,7 +1658,7 @@ func (m *Model) handleSettingsChange(dir int) {
 		}
 		m.Config.RefreshRate = m.RefreshRate
 
-	case 16: // Border Type
+	case appearanceBase + 2: // Border Type
 		types := config.GetBorderTypes()
 		for i, t := range types {
 			if t == m.BorderType {
@@ -840,7 +1669,7 @@ func (m *Model) handleSettingsChange(dir int) {
 		}
 		m.Config.BorderType = m.BorderType
 
-	case 17: // Border Style
+	case appearanceBase + 3: // Border Style
 		styles := config.GetBorderStyles()
 		for i, s := range styles {
 			if s == m.BorderStyle {
@@ -851,7 +1680,7 @@ func (m *Model) handleSettingsChange(dir int) {
 		}
 		m.Config.BorderStyle = m.BorderStyle
 
-	case 18: // Background
+	case appearanceBase + 4: // Background
 		m.BackgroundOpaque = !m.BackgroundOpaque
 		m.Config.BackgroundOpaque = m.BackgroundOpaque
 	}
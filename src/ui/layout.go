@@ -144,16 +144,57 @@ func MainViewFromState(s *data.AppState, getBorder func() lipgloss.Border, getCo
 
 	// Footer - context-aware
 	var footerText string
-	if s.SelectedTab == 2 {
+	currentTab := ""
+	if s.SelectedTab >= 0 && s.SelectedTab < len(s.ActiveTabs) {
+		currentTab = s.ActiveTabs[s.SelectedTab]
+	}
+	switch currentTab {
+	case "Processes":
 		if s.FilterMode {
-			footerText = "Type to filter • ESC/Return to apply filter"
+			footerText = `Type to filter (user:root cpu>5 mem>2% cmd~"re" state:Z !name:x) • ↑↓ History • ESC/Return to apply`
 		} else {
-			footerText = "Press ? for Help • f to Filter • K to Kill • S to Sort"
+			footerText = "Press ? for Help • f to Filter • b to Group • e for Events • m to Mark • K to Kill • S to Sort"
+			if len(s.MarkedPids) > 0 {
+				footerText = fmt.Sprintf("%d marked • K/s/z/x/+/- apply to marked • u to Unmark", len(s.MarkedPids))
+			}
 		}
-	} else {
+	case "Metrics":
+		if s.MetricsView == "interrupts" {
+			footerText = "v Charts • j/k Scroll • Press ? for Help"
+		} else {
+			footerText = "v Interrupts • N Group cores • Press ? for Help • q to Quit"
+		}
+	case "Network":
+		switch {
+		case s.ConnFilterMode:
+			footerText = "Type to filter (state:listen port:443 proc:nginx !proto:unix) • ESC/Return to apply"
+		case s.NetworkView == "connections":
+			footerText = "v Protocols • f Filter • c Clear • Enter Process • r Refresh"
+		case s.NetworkView == "protocols":
+			footerText = "v Probes • Press ? for Help • q to Quit"
+		case s.NetworkView == "probes":
+			footerText = "v Interfaces • r Probe now • Press ? for Help • q to Quit"
+		default:
+			footerText = "v Connections • Press ? for Help • q to Quit"
+		}
+	case "Disks":
+		footerText = "S Sort • b Group bind mounts • Press ? for Help • q to Quit"
+	case "Containers":
+		footerText = "s Start • t Stop • R Restart • z Pause • x Unpause • r Refresh"
+	case "Cgroups":
+		footerText = "Space Collapse/Expand • Enter Show processes • r Refresh"
+	case "Services":
+		footerText = "s Start • t Stop • R Restart • Enter Journal • r Refresh"
+	default:
 		footerText = "Press ? for Help • q to Quit"
 	}
 
+	// Filter parse errors are shown inline, replacing the hint text
+	if currentTab == "Processes" && s.FilterError != "" {
+		footerText = lipgloss.NewStyle().Foreground(a).Render("Filter error: "+s.FilterError) +
+			lipgloss.NewStyle().Foreground(mu).Render(" • last valid filter still applied")
+	}
+
 	// Footer Assembly
 	var footer string
 	if s.Width < 130 && alertStr != "" {
@@ -211,9 +252,29 @@ func MainViewFromState(s *data.AppState, getBorder func() lipgloss.Border, getCo
 	case "Disks":
 		content = tabs.RenderDisks(s, container, su, w, a, t, mu, p, b, availHeight)
 	case "Network":
-		content = tabs.RenderNetwork(s, container, titleStyle, labelStyle, valueStyle, t, mu, p, b, bg, availHeight)
+		if s.NetworkView == "connections" {
+			content = tabs.RenderConnections(s, container, su, w, a, t, mu, p, b, availHeight)
+			break
+		}
+		if s.NetworkView == "protocols" {
+			content = tabs.RenderProtocols(s, container, su, w, a, t, mu, p, b, availHeight)
+			break
+		}
+		if s.NetworkView == "probes" {
+			content = tabs.RenderProbes(s, container, su, w, a, t, mu, p, b, availHeight)
+			break
+		}
+		content = tabs.RenderNetwork(s, container, titleStyle, labelStyle, valueStyle, su, w, a, t, mu, p, b, bg, availHeight)
 	case "System":
 		content = tabs.RenderSystem(s, container, titleStyle, labelStyle, valueStyle, t, mu, p, b, bg, availHeight)
+	case "Memory":
+		content = tabs.RenderMemory(s, container, su, w, a, t, mu, p, b, availHeight)
+	case "Containers":
+		content = tabs.RenderContainers(s, container, su, w, a, t, mu, p, b, availHeight)
+	case "Cgroups":
+		content = tabs.RenderCgroups(s, container, su, w, a, t, mu, p, b, availHeight)
+	case "Services":
+		content = tabs.RenderServices(s, container, su, w, a, t, mu, p, b, availHeight)
 	default:
 		content = lipgloss.NewStyle().Foreground(mu).Render("Tab not found: " + activeTab)
 	}
@@ -302,6 +363,53 @@ func MainViewFromState(s *data.AppState, getBorder func() lipgloss.Border, getCo
 		layers = append(layers, dialogLayer)
 	}
 
+	if s.ShowContainerDialog {
+		containerDialog := overlays.RenderContainerDialog(s, b, p, a, t, mu)
+		dialogWidth := lipgloss.Width(containerDialog)
+		dialogHeight := lipgloss.Height(containerDialog)
+
+		dialogX := (s.Width - dialogWidth) / 2
+		dialogY := (s.Height - dialogHeight) / 2
+		if dialogX < 0 {
+			dialogX = 0
+		}
+		if dialogY < 0 {
+			dialogY = 0
+		}
+
+		layers = append(layers, lipgloss.NewLayer(containerDialog).X(dialogX).Y(dialogY).Z(3))
+	}
+
+	if s.ShowBatchDialog {
+		batchDialog := overlays.RenderBatchDialog(s, b, p, a, t, mu)
+		dialogWidth := lipgloss.Width(batchDialog)
+		dialogHeight := lipgloss.Height(batchDialog)
+
+		dialogX := (s.Width - dialogWidth) / 2
+		dialogY := (s.Height - dialogHeight) / 2
+		if dialogX < 0 {
+			dialogX = 0
+		}
+		if dialogY < 0 {
+			dialogY = 0
+		}
+
+		layers = append(layers, lipgloss.NewLayer(batchDialog).X(dialogX).Y(dialogY).Z(3))
+	}
+
+	if s.ShowSchedDialog {
+		schedDialog := overlays.RenderSchedDialog(s, b, p, t, mu)
+		dialogX := (s.Width - lipgloss.Width(schedDialog)) / 2
+		dialogY := (s.Height - lipgloss.Height(schedDialog)) / 2
+		if dialogX < 0 {
+			dialogX = 0
+		}
+		if dialogY < 0 {
+			dialogY = 0
+		}
+		layers = append(layers, lipgloss.NewLayer(schedDialog).X(dialogX).Y(dialogY).Z(3))
+	}
+
 	if s.ShowHelp {
 		helpBox := overlays.RenderHelp(s, b, p, bg)
 		hWidth := lipgloss.Width(helpBox)
@@ -347,6 +455,53 @@ func MainViewFromState(s *data.AppState, getBorder func() lipgloss.Border, getCo
 		layers = append(layers, lipgloss.NewLayer(filesBox).X(fX).Y(fY).Z(4))
 	}
 
+	if s.ShowServiceDialog {
+		serviceDialog := overlays.RenderServiceDialog(s, b, p, a, t, mu)
+		dialogWidth := lipgloss.Width(serviceDialog)
+		dialogHeight := lipgloss.Height(serviceDialog)
+
+		dialogX := (s.Width - dialogWidth) / 2
+		dialogY := (s.Height - dialogHeight) / 2
+		if dialogX < 0 {
+			dialogX = 0
+		}
+		if dialogY < 0 {
+			dialogY = 0
+		}
+
+		layers = append(layers, lipgloss.NewLayer(serviceDialog).X(dialogX).Y(dialogY).Z(3))
+	}
+
+	if s.ShowJournal {
+		journalBox := overlays.RenderJournalOverlay(s, s.Width, s.Height, b, p, t, mu)
+		jWidth := lipgloss.Width(journalBox)
+		jHeight := lipgloss.Height(journalBox)
+		jX := (s.Width - jWidth) / 2
+		jY := (s.Height - jHeight) / 2
+		if jX < 0 {
+			jX = 0
+		}
+		if jY < 0 {
+			jY = 0
+		}
+		layers = append(layers, lipgloss.NewLayer(journalBox).X(jX).Y(jY).Z(4))
+	}
+
+	if s.ShowEvents {
+		eventsBox := overlays.RenderEventsOverlay(s, s.Width, s.Height, b, p, t, mu, su, a)
+		eWidth := lipgloss.Width(eventsBox)
+		eHeight := lipgloss.Height(eventsBox)
+		eX := (s.Width - eWidth) / 2
+		eY := (s.Height - eHeight) / 2
+		if eX < 0 {
+			eX = 0
+		}
+		if eY < 0 {
+			eY = 0
+		}
+		layers = append(layers, lipgloss.NewLayer(eventsBox).X(eX).Y(eY).Z(4))
+	}
+
 	// Create view from layers
 	canvas := lipgloss.NewCanvas(layers...)
 	v := tea.NewView(canvas)
@@ -0,0 +1,92 @@
+package overlays
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/ui/widgets"
+	"github.com/N1xev/bubbleMonitor/src/utils"
+)
+
+// maxBatchRows limits how many targets are listed in the batch dialog
+const maxBatchRows = 10
+
+// RenderBatchDialog renders the confirmation dialog for a batch process action
+func RenderBatchDialog(s *data.AppState, b, p, danger, t, mu compat.AdaptiveColor) string {
+	boxWidth := 60
+	if boxWidth > s.Width-4 {
+		boxWidth = s.Width - 4
+	}
+
+	warningStyle := lipgloss.NewStyle().Foreground(danger).Bold(true)
+	labelStyle := lipgloss.NewStyle().Foreground(mu)
+	valueStyle := lipgloss.NewStyle().Foreground(t).Bold(true)
+	keyStyle := lipgloss.NewStyle().Foreground(p).Bold(true)
+
+	var verb string
+	switch s.BatchAction {
+	case "kill":
+		verb = "KILL"
+	case "signal":
+		verb = "SEND " + s.BatchSignal + " TO"
+	case "suspend":
+		verb = "SUSPEND"
+	case "resume":
+		verb = "RESUME"
+	case "renice":
+		if s.BatchDelta < 0 {
+			verb = "RAISE PRIORITY OF"
+		} else {
+			verb = "LOWER PRIORITY OF"
+		}
+	default:
+		verb = strings.ToUpper(s.BatchAction)
+	}
+
+	title := fmt.Sprintf("⚠ %s %d PROCESS", verb, len(s.BatchTargets))
+	if len(s.BatchTargets) != 1 {
+		title += "ES"
+	}
+	title += "?"
+
+	lines := []string{warningStyle.Render(title), ""}
+	for i, proc := range s.BatchTargets {
+		if i == maxBatchRows {
+			lines = append(lines, labelStyle.Render(fmt.Sprintf("... and %d more", len(s.BatchTargets)-maxBatchRows)))
+			break
+		}
+		lines = append(lines, labelStyle.Render(fmt.Sprintf("%-8d", proc.Pid))+valueStyle.Render(utils.Truncate(proc.Name, 30)))
+	}
+
+	lines = append(lines, "")
+	if s.BatchAction == "signal" {
+		lines = append(lines, labelStyle.Render("Signal: ")+keyStyle.Render("◀ "+s.BatchSignal+" ▶"), "")
+	}
+
+	lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Center,
+		keyStyle.Render("[Y]")+" "+labelStyle.Render("Confirm"),
+		"   ",
+		keyStyle.Render("[N]")+" "+labelStyle.Render("Cancel"),
+	))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+
+	border := widgets.GetBorder(s.BorderStyle, s.BorderType)
+
+	container := lipgloss.NewStyle().
+		Border(border).
+		BorderForeground(danger).
+		Padding(1, 3).
+		Width(boxWidth - 6).
+		BorderTop(false)
+
+	body := container.Render(content)
+	actualWidth := lipgloss.Width(body)
+	topBorder := widgets.RenderTopBorderWithBg("CONFIRM BATCH ACTION", actualWidth, border, danger, p)
+
+	return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+}
@@ -0,0 +1,59 @@
+package overlays
+
+import (
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/ui/widgets"
+)
+
+// RenderContainerDialog renders the confirmation dialog for a container action
+func RenderContainerDialog(s *data.AppState, b, p, danger, t, mu compat.AdaptiveColor) string {
+	boxWidth := 50
+	if boxWidth > s.Width-4 {
+		boxWidth = s.Width - 4
+	}
+
+	warningStyle := lipgloss.NewStyle().Foreground(danger).Bold(true)
+	labelStyle := lipgloss.NewStyle().Foreground(mu)
+	valueStyle := lipgloss.NewStyle().Foreground(t).Bold(true)
+	keyStyle := lipgloss.NewStyle().Foreground(p).Bold(true)
+
+	verb := strings.ToUpper(s.ContainerAction)
+	id := s.ContainerTarget.ID
+	if len(id) > 12 {
+		id = id[:12]
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Center,
+		warningStyle.Render("⚠ "+verb+" CONTAINER?"),
+		"",
+		labelStyle.Render("Name: ")+valueStyle.Render(s.ContainerTarget.Name),
+		labelStyle.Render("ID: ")+valueStyle.Render(id),
+		labelStyle.Render("Image: ")+valueStyle.Render(s.ContainerTarget.Image),
+		"",
+		lipgloss.JoinHorizontal(lipgloss.Center,
+			keyStyle.Render("[Y]")+" "+labelStyle.Render(strings.Title(s.ContainerAction)),
+			"   ",
+			keyStyle.Render("[N]")+" "+labelStyle.Render("Cancel"),
+		),
+	)
+
+	border := widgets.GetBorder(s.BorderStyle, s.BorderType)
+
+	container := lipgloss.NewStyle().
+		Border(border).
+		BorderForeground(danger).
+		Padding(1, 3).
+		Width(boxWidth - 6).
+		BorderTop(false)
+
+	body := container.Render(content)
+	actualWidth := lipgloss.Width(body)
+	topBorder := widgets.RenderTopBorderWithBg("CONFIRM "+verb, actualWidth, border, danger, p)
+
+	return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+}
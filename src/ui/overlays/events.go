@@ -0,0 +1,136 @@
+package overlays
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/ui/widgets"
+	"github.com/N1xev/bubbleMonitor/src/utils"
+)
+
+// RenderEventsOverlay renders the process start/exit event log, newest first
+func RenderEventsOverlay(s *data.AppState, width, height int, b, p, t, mu, su, a compat.AdaptiveColor) string {
+	boxWidth := 100
+	if boxWidth > width-4 {
+		boxWidth = width - 4
+	}
+	boxHeight := 24
+	if boxHeight > height-4 {
+		boxHeight = height - 4
+	}
+	rows := boxHeight - 6
+	if rows < 3 {
+		rows = 3
+	}
+
+	border := widgets.GetBorder(s.BorderStyle, s.BorderType)
+	contentWidth := boxWidth - 10
+	if contentWidth < 40 {
+		contentWidth = 40
+	}
+
+	timeWidth := 9
+	typeWidth := 6
+	pidWidth := 8
+	userWidth := 10
+	lifeWidth := 10
+	cpuWidth := 7
+	memWidth := 10
+	nameWidth := contentWidth - timeWidth - typeWidth - pidWidth - userWidth - lifeWidth - cpuWidth - memWidth
+	if nameWidth < 10 {
+		nameWidth = 10
+	}
+
+	hdrStyle := lipgloss.NewStyle().Foreground(mu).Bold(true)
+	header := hdrStyle.Render(
+		fmt.Sprintf("%-*s%-*s%-*s%-*s%-*s%*s%*s%*s",
+			timeWidth, "TIME", typeWidth, "EVENT", pidWidth, "PID", userWidth, "USER",
+			nameWidth, "NAME", lifeWidth, "LIFETIME", cpuWidth, "CPU", memWidth, "PEAK MEM"))
+
+	startStyle := lipgloss.NewStyle().Foreground(su).Bold(true)
+	exitStyle := lipgloss.NewStyle().Foreground(a).Bold(true)
+	textStyle := lipgloss.NewStyle().Foreground(t)
+
+	total := len(s.ProcessEvents)
+	offset := s.EventsScrollOffset
+	if offset > total-rows {
+		offset = total - rows
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	var lines []string
+	for i := 0; i < rows && offset+i < total; i++ {
+		e := s.ProcessEvents[total-1-offset-i]
+
+		typeStyle := startStyle
+		if e.Type == "exit" {
+			typeStyle = exitStyle
+		}
+
+		user := utils.Truncate(e.Username, userWidth-1)
+		name := e.Name
+		if e.PidReused {
+			name += " (reused)"
+		}
+		name = utils.Truncate(name, nameWidth-1)
+
+		life, cpu, mem := "", "", ""
+		if e.Type == "exit" {
+			life = utils.FormatDuration(e.Lifetime)
+			if e.Lifetime < 1e9 {
+				life = fmt.Sprintf("%dms", e.Lifetime.Milliseconds())
+			}
+			cpu = fmt.Sprintf("%.1f%%", e.PeakCpu)
+			mem = utils.FormatBytes(e.PeakMemory)
+			if e.PeakMemory == 0 {
+				cpu, mem = "-", "-"
+			}
+		}
+
+		lines = append(lines,
+			textStyle.Render(fmt.Sprintf("%-*s", timeWidth, e.Time.Format("15:04:05")))+
+				typeStyle.Render(fmt.Sprintf("%-*s", typeWidth, strings.ToUpper(e.Type)))+
+				textStyle.Render(fmt.Sprintf("%-*d", pidWidth, e.Pid)+
+					utils.FullWidthBg(user, userWidth)+utils.FullWidthBg(name, nameWidth)+
+					fmt.Sprintf("%*s%*s%*s", lifeWidth, life, cpuWidth, cpu, memWidth, mem)))
+	}
+	if total == 0 {
+		lines = append(lines, lipgloss.NewStyle().Foreground(mu).Render("No process has started or exited yet."))
+	}
+	for len(lines) < rows {
+		lines = append(lines, "")
+	}
+
+	hint := lipgloss.NewStyle().Foreground(mu).Italic(true).Render("↑↓/jk to scroll • g/G newest/oldest • c to clear • e or ESC to close")
+
+	container := lipgloss.NewStyle().
+		Border(border).
+		BorderForeground(b).
+		Padding(1, 2).
+		Width(boxWidth - 6).
+		BorderTop(false)
+
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		header,
+		strings.Join(lines, "\n"),
+		"",
+		hint,
+	)
+
+	body := container.Render(content)
+	actualWidth := lipgloss.Width(body)
+
+	title := fmt.Sprintf("PROCESS EVENTS (%d)", total)
+	if total > rows {
+		title = fmt.Sprintf("PROCESS EVENTS [%d-%d of %d]", offset+1, offset+len(lines), total)
+	}
+	topBorder := widgets.RenderTopBorderWithBg(title, actualWidth, border, b, p)
+
+	return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+}
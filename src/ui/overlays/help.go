@@ -52,7 +52,7 @@ func RenderHelp(s *data.AppState, b, p, bg compat.AdaptiveColor) string {
 			sec.Width(colWidth).Render("NAVIGATION"),
 			spacer.Width(colWidth).Render(key.Render("Tab / → / L")+sp("  ")+desc.Render("Next tab")),
 			spacer.Width(colWidth).Render(key.Render("Shift+Tab / ← / h")+sp("    ")+desc.Render("Previous tab")),
-			spacer.Width(colWidth).Render(key.Render("1-6")+sp("  ")+desc.Render("Jump to tab")),
+			spacer.Width(colWidth).Render(key.Render("1-9")+sp("  ")+desc.Render("Jump to tab")),
 			spacer.Width(colWidth).Render(""),
 			sec.Width(colWidth).Render("CONTROLS"),
 			spacer.Width(colWidth).Render(key.Render("P")+sp(" ")+desc.Render("Pause/Resume")),
@@ -63,6 +63,24 @@ func RenderHelp(s *data.AppState, b, p, bg compat.AdaptiveColor) string {
 			spacer.Width(colWidth).Render(key.Render(".")+sp(" ")+desc.Render("Settings")),
 			spacer.Width(colWidth).Render(key.Render("H")+sp(" ")+desc.Render("History len")),
 			spacer.Width(colWidth).Render(key.Render("C")+sp(" ")+desc.Render("Chart type")),
+			spacer.Width(colWidth).Render(""),
+			sec.Width(colWidth).Render("DISKS TAB"),
+			spacer.Width(colWidth).Render(key.Render("S")+sp("     ")+desc.Render("Sort partitions")),
+			spacer.Width(colWidth).Render(key.Render("b")+sp("     ")+desc.Render("Group bind mounts")),
+			spacer.Width(colWidth).Render(""),
+			sec.Width(colWidth).Render("CONTAINERS TAB"),
+			spacer.Width(colWidth).Render(key.Render("s / t")+sp(" ")+desc.Render("Start / stop")),
+			spacer.Width(colWidth).Render(key.Render("R")+sp("     ")+desc.Render("Restart")),
+			spacer.Width(colWidth).Render(key.Render("z / x")+sp(" ")+desc.Render("Pause / unpause")),
+			spacer.Width(colWidth).Render(""),
+			sec.Width(colWidth).Render("CGROUPS TAB"),
+			spacer.Width(colWidth).Render(key.Render("Space")+sp(" ")+desc.Render("Collapse/Exp")),
+			spacer.Width(colWidth).Render(key.Render("Enter")+sp(" ")+desc.Render("Show processes")),
+			spacer.Width(colWidth).Render(""),
+			sec.Width(colWidth).Render("SERVICES TAB"),
+			spacer.Width(colWidth).Render(key.Render("s / t")+sp(" ")+desc.Render("Start / stop")),
+			spacer.Width(colWidth).Render(key.Render("R")+sp("     ")+desc.Render("Restart")),
+			spacer.Width(colWidth).Render(key.Render("Enter")+sp(" ")+desc.Render("Journal")),
 		)
 
 		rightCol := lipgloss.JoinVertical(lipgloss.Left,
@@ -71,15 +89,28 @@ func RenderHelp(s *data.AppState, b, p, bg compat.AdaptiveColor) string {
 			spacer.Width(colWidth).Render(key.Render("k / ↑")+sp(" ")+desc.Render("Move up")),
 			spacer.Width(colWidth).Render(key.Render("g / G")+sp(" ")+desc.Render("Top / bottom")),
 			spacer.Width(colWidth).Render(key.Render("f")+sp("     ")+desc.Render("Filter")),
-			spacer.Width(colWidth).Render(key.Render("c")+sp("     ")+desc.Render("Clear filter")),
+			spacer.Width(colWidth).Render(key.Render("c / F")+sp(" ")+desc.Render("Clear / saved filter")),
 			spacer.Width(colWidth).Render(key.Render("z / x")+sp(" ")+desc.Render("Suspend/Resume")),
 			spacer.Width(colWidth).Render(key.Render("K")+sp("     ")+desc.Render("Kill process")),
 			spacer.Width(colWidth).Render(key.Render("o")+sp("     ")+desc.Render("Open files")),
+			spacer.Width(colWidth).Render(key.Render("e")+sp("     ")+desc.Render("Start/exit events")),
 			spacer.Width(colWidth).Render(key.Render("T")+sp("     ")+desc.Render("Tree view")),
+			spacer.Width(colWidth).Render(key.Render("b")+sp("     ")+desc.Render("Group by")),
 			spacer.Width(colWidth).Render(key.Render("Space")+sp(" ")+desc.Render("Collapse/Exp")),
 			spacer.Width(colWidth).Render(key.Render("+ / -")+sp(" ")+desc.Render("Nice +/-")),
+			spacer.Width(colWidth).Render(key.Render("m / M")+sp(" ")+desc.Render("Mark / subtree")),
+			spacer.Width(colWidth).Render(key.Render("a / u")+sp(" ")+desc.Render("Mark all / clear")),
+			spacer.Width(colWidth).Render(key.Render("s")+sp("     ")+desc.Render("Send signal")),
+			spacer.Width(colWidth).Render(key.Render("I")+sp("     ")+desc.Render("Nice/IO/affinity")),
 			spacer.Width(colWidth).Render(""),
+			sec.Width(colWidth).Render("METRICS TAB"),
+			spacer.Width(colWidth).Render(key.Render("v")+sp("     ")+desc.Render("Interrupts view")),
+			spacer.Width(colWidth).Render(key.Render("N")+sp("     ")+desc.Render("Group cores")),
 			spacer.Width(colWidth).Render(""),
+			sec.Width(colWidth).Render("NETWORK TAB"),
+			spacer.Width(colWidth).Render(key.Render("v")+sp("     ")+desc.Render("Cycle network views")),
+			spacer.Width(colWidth).Render(key.Render("f / c")+sp(" ")+desc.Render("Filter / clear")),
+			spacer.Width(colWidth).Render(key.Render("Enter")+sp(" ")+desc.Render("Owning process")),
 			lipgloss.NewStyle().Foreground(compat.AdaptiveColor{Light: lipgloss.Color("#6B7280"), Dark: lipgloss.Color("#9CA3AF")}).Italic(true).Width(colWidth).Render("Press ? or ESC to close"),
 		)
 
@@ -97,7 +128,7 @@ func RenderHelp(s *data.AppState, b, p, bg compat.AdaptiveColor) string {
 			sec.Width(contentWidth).Render("NAVIGATION"),
 			spacer.Width(contentWidth).Render(key.Render("Tab/→/L")+" "+desc.Render("Next")),
 			spacer.Width(contentWidth).Render(key.Render("S-Tab/←")+" "+desc.Render("Prev")),
-			spacer.Width(contentWidth).Render(key.Render("1-5")+" "+desc.Render("Jump to tab")),
+			spacer.Width(contentWidth).Render(key.Render("1-9")+" "+desc.Render("Jump to tab")),
 			spacer.Width(contentWidth).Render(""),
 			sec.Width(contentWidth).Render("CONTROLS"),
 			spacer.Width(contentWidth).Render(key.Render("P")+" "+desc.Render("Pause")),
@@ -118,7 +149,7 @@ func RenderHelp(s *data.AppState, b, p, bg compat.AdaptiveColor) string {
 			sec.Width(contentWidth).Render("NAVIGATION"),
 			spacer.Width(contentWidth).Render(key.Render("Tab / → / L")+sp("       ")+desc.Render("Next tab")),
 			spacer.Width(contentWidth).Render(key.Render("Shift+Tab / ←")+sp("     ")+desc.Render("Previous tab")),
-			spacer.Width(contentWidth).Render(key.Render("1-5")+sp("               ")+desc.Render("Jump to specific tab")),
+			spacer.Width(contentWidth).Render(key.Render("1-9")+sp("               ")+desc.Render("Jump to specific tab")),
 			spacer.Width(contentWidth).Render(""),
 			sec.Width(contentWidth).Render("CONTROLS"),
 			spacer.Width(contentWidth).Render(key.Render("P")+sp("   ")+desc.Render("Pause/Resume monitoring")),
@@ -135,11 +166,47 @@ func RenderHelp(s *data.AppState, b, p, bg compat.AdaptiveColor) string {
 			spacer.Width(contentWidth).Render(key.Render("g / G")+sp("   ")+desc.Render("Go to top / bottom")),
 			spacer.Width(contentWidth).Render(key.Render("f")+sp("       ")+desc.Render("Filter processes")),
 			spacer.Width(contentWidth).Render(key.Render("c")+sp("       ")+desc.Render("Clear filter")),
+			spacer.Width(contentWidth).Render(key.Render("F")+sp("       ")+desc.Render("Cycle saved filters")),
 			spacer.Width(contentWidth).Render(key.Render("K")+sp("       ")+desc.Render("Kill selected process")),
 			spacer.Width(contentWidth).Render(key.Render("o")+sp("       ")+desc.Render("Open files")),
+			spacer.Width(contentWidth).Render(key.Render("e")+sp("       ")+desc.Render("Process start/exit events")),
 			spacer.Width(contentWidth).Render(key.Render("T")+sp("       ")+desc.Render("Toggle tree view")),
-			spacer.Width(contentWidth).Render(key.Render("Space")+sp("   ")+desc.Render("Collapse/Expand tree node")),
+			spacer.Width(contentWidth).Render(key.Render("b")+sp("       ")+desc.Render("Group by name/exe/user/cgroup/container")),
+			spacer.Width(contentWidth).Render(key.Render("Space")+sp("   ")+desc.Render("Collapse/Expand tree node or group")),
 			spacer.Width(contentWidth).Render(key.Render("+ / -")+sp("   ")+desc.Render("Increase/Decrease priority")),
+			spacer.Width(contentWidth).Render(key.Render("m / M")+sp("   ")+desc.Render("Mark process / mark subtree")),
+			spacer.Width(contentWidth).Render(key.Render("a / u")+sp("   ")+desc.Render("Mark all filtered / clear marks")),
+			spacer.Width(contentWidth).Render(key.Render("s")+sp("       ")+desc.Render("Send signal to marked/selected")),
+			spacer.Width(contentWidth).Render(key.Render("I")+sp("       ")+desc.Render("Edit nice, I/O priority, CPU affinity")),
+			spacer.Width(contentWidth).Render(""),
+			sec.Width(contentWidth).Render("METRICS TAB"),
+			spacer.Width(contentWidth).Render(key.Render("v")+sp("       ")+desc.Render("Toggle charts / interrupts view")),
+			spacer.Width(contentWidth).Render(key.Render("j / k")+sp("   ")+desc.Render("Scroll interrupts")),
+			spacer.Width(contentWidth).Render(key.Render("N")+sp("       ")+desc.Render("Group cores by socket / NUMA node")),
+			spacer.Width(contentWidth).Render(""),
+			sec.Width(contentWidth).Render("NETWORK TAB"),
+			spacer.Width(contentWidth).Render(key.Render("v")+sp("       ")+desc.Render("Cycle interfaces / connections / protocols / probes")),
+			spacer.Width(contentWidth).Render(key.Render("f / c")+sp("   ")+desc.Render("Filter connections / clear filter")),
+			spacer.Width(contentWidth).Render(key.Render("Enter")+sp("   ")+desc.Render("Show the socket's process")),
+			spacer.Width(contentWidth).Render(key.Render("r")+sp("       ")+desc.Render("Refresh connections / run probes now")),
+			spacer.Width(contentWidth).Render(""),
+			sec.Width(contentWidth).Render("DISKS TAB"),
+			spacer.Width(contentWidth).Render(key.Render("S")+sp("       ")+desc.Render("Sort partitions (mount order/name/usage/size)")),
+			spacer.Width(contentWidth).Render(key.Render("b")+sp("       ")+desc.Render("Group bind mounts of the same filesystem")),
+			spacer.Width(contentWidth).Render(""),
+			sec.Width(contentWidth).Render("CONTAINERS TAB"),
+			spacer.Width(contentWidth).Render(key.Render("s / t")+sp("   ")+desc.Render("Start / stop container")),
+			spacer.Width(contentWidth).Render(key.Render("R")+sp("       ")+desc.Render("Restart container")),
+			spacer.Width(contentWidth).Render(key.Render("z / x")+sp("   ")+desc.Render("Pause / unpause container")),
+			spacer.Width(contentWidth).Render(""),
+			sec.Width(contentWidth).Render("CGROUPS TAB"),
+			spacer.Width(contentWidth).Render(key.Render("Space")+sp("   ")+desc.Render("Collapse/Expand cgroup")),
+			spacer.Width(contentWidth).Render(key.Render("Enter")+sp("   ")+desc.Render("Show the cgroup's processes")),
+			spacer.Width(contentWidth).Render(""),
+			sec.Width(contentWidth).Render("SERVICES TAB"),
+			spacer.Width(contentWidth).Render(key.Render("s / t")+sp("   ")+desc.Render("Start / stop unit")),
+			spacer.Width(contentWidth).Render(key.Render("R")+sp("       ")+desc.Render("Restart unit")),
+			spacer.Width(contentWidth).Render(key.Render("Enter")+sp("   ")+desc.Render("Tail the unit's journal")),
 			spacer.Width(contentWidth).Render(""),
 			lipgloss.NewStyle().Foreground(compat.AdaptiveColor{Light: lipgloss.Color("#6B7280"), Dark: lipgloss.Color("#9CA3AF")}).Italic(true).Width(contentWidth).Render("Press ? or ESC to close"),
 		)
@@ -147,11 +214,11 @@ func RenderHelp(s *data.AppState, b, p, bg compat.AdaptiveColor) string {
 
 	var boxHeight int
 	if useTwoColumns {
-		boxHeight = 18
+		boxHeight = 21
 	} else if isCompact {
 		boxHeight = 18
 	} else {
-		boxHeight = 32
+		boxHeight = 69
 	}
 	maxHeight := int(float64(s.Height) * 0.8)
 	if boxHeight > maxHeight {
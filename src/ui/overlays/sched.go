@@ -0,0 +1,137 @@
+package overlays
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/ui/widgets"
+)
+
+// RenderSchedDialog renders the nice / I/O priority / CPU affinity editor
+func RenderSchedDialog(s *data.AppState, b, p, t, mu compat.AdaptiveColor) string {
+	boxWidth := 64
+	if boxWidth > s.Width-4 {
+		boxWidth = s.Width - 4
+	}
+
+	labelStyle := lipgloss.NewStyle().Foreground(mu)
+	valueStyle := lipgloss.NewStyle().Foreground(t).Bold(true)
+	selectedStyle := lipgloss.NewStyle().Foreground(p).Bold(true)
+	changedStyle := lipgloss.NewStyle().Foreground(p)
+
+	info := s.SchedInfo
+	orig := s.SchedOriginal
+
+	// Render a field row, highlighting the active one and marking edits
+	row := func(idx int, label, value string, changed bool) string {
+		marker := "  "
+		style := valueStyle
+		if s.SchedField == idx {
+			marker = "▶ "
+			style = selectedStyle
+		}
+		line := labelStyle.Render(fmt.Sprintf("%s%-12s", marker, label)) + style.Render(value)
+		if changed {
+			line += changedStyle.Render(" *")
+		}
+		return line
+	}
+
+	var lines []string
+	lines = append(lines,
+		labelStyle.Render("PID: ")+valueStyle.Render(fmt.Sprintf("%d", s.SchedPid))+"   "+
+			labelStyle.Render("Name: ")+valueStyle.Render(s.SchedName),
+		"",
+	)
+
+	if s.SchedLoading {
+		lines = append(lines, labelStyle.Render("Reading scheduling parameters..."))
+	} else {
+		lines = append(lines, row(0, "Nice:", fmt.Sprintf("◀ %d ▶", info.Nice), info.Nice != orig.Nice))
+
+		if info.IOSupported {
+			lines = append(lines,
+				row(1, "I/O Class:", "◀ "+data.IOClassNames[info.IOClass]+" ▶", info.IOClass != orig.IOClass),
+				row(2, "I/O Level:", fmt.Sprintf("◀ %d ▶", info.IOLevel), info.IOLevel != orig.IOLevel),
+			)
+		} else {
+			lines = append(lines, labelStyle.Render("  I/O Priority: not supported"))
+		}
+
+		if info.AffinitySupported {
+			var cells []string
+			for i, allowed := range info.Affinity {
+				cell := "·"
+				if allowed {
+					cell = "■"
+				}
+				if s.SchedField == 3 && s.SchedCpuCursor == i {
+					cells = append(cells, selectedStyle.Underline(true).Render(cell))
+				} else if allowed {
+					cells = append(cells, valueStyle.Render(cell))
+				} else {
+					cells = append(cells, labelStyle.Render(cell))
+				}
+			}
+			changed := false
+			for i := range info.Affinity {
+				if i < len(orig.Affinity) && orig.Affinity[i] != info.Affinity[i] {
+					changed = true
+				}
+			}
+			lines = append(lines, row(3, "Affinity:", fmt.Sprintf("%d/%d CPUs", countAllowed(info.Affinity), len(info.Affinity)), changed))
+
+			// Wrap the CPU grid so large machines still fit in the dialog
+			perLine := boxWidth - 12
+			if perLine < 8 {
+				perLine = 8
+			}
+			for i := 0; i < len(cells); i += perLine {
+				end := i + perLine
+				if end > len(cells) {
+					end = len(cells)
+				}
+				lines = append(lines, "    "+strings.Join(cells[i:end], ""))
+			}
+			if s.SchedField == 3 {
+				lines = append(lines, labelStyle.Render(fmt.Sprintf("    CPU %d", s.SchedCpuCursor)))
+			}
+		} else {
+			lines = append(lines, labelStyle.Render("  CPU Affinity: not supported"))
+		}
+	}
+
+	hint := labelStyle.Italic(true).Render("↑↓ field • ←→ change • Space toggle CPU • a all CPUs • Enter apply • Esc cancel")
+	lines = append(lines, "", hint)
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+
+	border := widgets.GetBorder(s.BorderStyle, s.BorderType)
+	container := lipgloss.NewStyle().
+		Border(border).
+		BorderForeground(b).
+		Padding(1, 2).
+		Width(boxWidth - 4).
+		BorderTop(false)
+
+	body := container.Render(content)
+	actualWidth := lipgloss.Width(body)
+	topBorder := widgets.RenderTopBorderWithBg("SCHEDULING", actualWidth, border, b, p)
+
+	return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+}
+
+// countAllowed returns the number of CPUs set in an affinity mask
+func countAllowed(cpus []bool) int {
+	n := 0
+	for _, allowed := range cpus {
+		if allowed {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,108 @@
+package overlays
+
+import (
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/ui/widgets"
+)
+
+// RenderServiceDialog renders the confirmation dialog for a service action
+func RenderServiceDialog(s *data.AppState, b, p, danger, t, mu compat.AdaptiveColor) string {
+	boxWidth := 50
+	if boxWidth > s.Width-4 {
+		boxWidth = s.Width - 4
+	}
+
+	warningStyle := lipgloss.NewStyle().Foreground(danger).Bold(true)
+	labelStyle := lipgloss.NewStyle().Foreground(mu)
+	valueStyle := lipgloss.NewStyle().Foreground(t).Bold(true)
+	keyStyle := lipgloss.NewStyle().Foreground(p).Bold(true)
+
+	verb := strings.ToUpper(s.ServiceAction)
+
+	content := lipgloss.JoinVertical(lipgloss.Center,
+		warningStyle.Render("⚠ "+verb+" SERVICE?"),
+		"",
+		labelStyle.Render("Unit: ")+valueStyle.Render(s.ServiceTarget),
+		"",
+		lipgloss.JoinHorizontal(lipgloss.Center,
+			keyStyle.Render("[Y]")+" "+labelStyle.Render(strings.Title(s.ServiceAction)),
+			"   ",
+			keyStyle.Render("[N]")+" "+labelStyle.Render("Cancel"),
+		),
+	)
+
+	border := widgets.GetBorder(s.BorderStyle, s.BorderType)
+
+	container := lipgloss.NewStyle().
+		Border(border).
+		BorderForeground(danger).
+		Padding(1, 3).
+		Width(boxWidth - 6).
+		BorderTop(false)
+
+	body := container.Render(content)
+	actualWidth := lipgloss.Width(body)
+	topBorder := widgets.RenderTopBorderWithBg("CONFIRM "+verb, actualWidth, border, danger, p)
+
+	return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+}
+
+// RenderJournalOverlay renders the journal tail of a service unit
+func RenderJournalOverlay(s *data.AppState, width, height int, b, p, t, mu compat.AdaptiveColor) string {
+	boxWidth := 140
+	if boxWidth > width-4 {
+		boxWidth = width - 4
+	}
+	boxHeight := 30
+	if boxHeight > height-4 {
+		boxHeight = height - 4
+	}
+
+	border := widgets.GetBorder(s.BorderStyle, s.BorderType)
+
+	vpWidth := boxWidth - 10
+	vpHeight := boxHeight - 4
+	if vpWidth < 10 {
+		vpWidth = 10
+	}
+	if vpHeight < 5 {
+		vpHeight = 5
+	}
+	s.JournalView.Width = vpWidth
+	s.JournalView.Height = vpHeight
+
+	// Cut long lines rather than wrapping so scrolling stays line-based
+	lines := strings.Split(s.JournalView.View(), "\n")
+	for i, line := range lines {
+		if runes := []rune(line); len(runes) > vpWidth {
+			lines[i] = string(runes[:vpWidth-1]) + "…"
+		}
+	}
+
+	container := lipgloss.NewStyle().
+		Border(border).
+		BorderForeground(b).
+		Padding(1, 2).
+		Width(boxWidth - 6).
+		Height(boxHeight).
+		BorderTop(false)
+
+	hint := lipgloss.NewStyle().Foreground(mu).Italic(true).Render("↑↓/jk scroll • g/G top/bottom (G follows) • Enter/ESC close")
+
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.NewStyle().Foreground(t).Render(strings.Join(lines, "\n")),
+		"",
+		hint,
+	)
+
+	body := container.Render(content)
+	actualWidth := lipgloss.Width(body)
+	topBorder := widgets.RenderTopBorderWithBg("JOURNAL: "+s.JournalUnit, actualWidth, border, b, p)
+
+	return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+}
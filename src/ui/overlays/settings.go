@@ -18,34 +18,33 @@ func RenderSettingsOverlay(s *data.AppState, width, height int, b, p, t, mu, bg
 		boxWidth = width - 4
 	}
 
-	boxHeight := 22
+	boxHeight := 24
 	border := widgets.GetBorder(s.BorderStyle, s.BorderType)
 
 	itemStyle := lipgloss.NewStyle().Foreground(t)
 	selectedStyle := lipgloss.NewStyle().Foreground(p).Bold(true).Border(border, false, false, false, true).BorderForeground(p).PaddingLeft(1)
 	headerStyle := lipgloss.NewStyle().Foreground(p).Bold(true).MarginBottom(1)
 
-	var col1 []string
-	col1 = append(col1, headerStyle.Render("THRESHOLDS & DISPLAY"))
+	// Rows are numbered in the order the settings keys walk them: the
+	// thresholds, the display settings, the tabs, then appearance
+	thresholds := config.SettingsThresholds()
 
-	thresholdItems := []struct {
-		label  string
-		metric config.MetricType
-		idx    int
-	}{
-		{"CPU Alert:", config.MetricCPU, 0},
-		{"Mem Alert:", config.MetricMem, 1},
-		{"Disk Alert:", config.MetricDisk, 2},
-		{"Temp Alert:", config.MetricTemp, 3},
-	}
+	var col1 []string
+	for idx, item := range thresholds {
+		if idx == 0 || item.Section != thresholds[idx-1].Section {
+			if idx > 0 {
+				col1 = append(col1, "")
+			}
+			col1 = append(col1, headerStyle.Render(item.Section))
+		}
 
-	for _, item := range thresholdItems {
-		val := fmt.Sprintf("%.0f%%", s.Config.Thresholds[item.metric])
-		if item.metric == config.MetricTemp {
-			val = fmt.Sprintf("%.0f°C", s.Config.Thresholds[item.metric])
+		v := s.Config.Thresholds[item.Metric]
+		val := fmt.Sprintf("%.0f%s", v, item.Unit)
+		if item.ZeroOff && v == 0 {
+			val = "off"
 		}
-		line := fmt.Sprintf("%-15s %s", item.label, val)
-		if s.SettingsIdx == item.idx {
+		line := fmt.Sprintf("%-15s %s", item.Label, val)
+		if s.SettingsIdx == idx {
 			col1 = append(col1, selectedStyle.Render(line))
 		} else {
 			col1 = append(col1, itemStyle.Render("  "+line))
@@ -53,21 +52,23 @@ func RenderSettingsOverlay(s *data.AppState, width, height int, b, p, t, mu, bg
 	}
 
 	col1 = append(col1, "")
+	col1 = append(col1, headerStyle.Render("DISPLAY"))
 
 	viewName := "normal"
 	if s.TreeView {
 		viewName = "tree"
 	}
 
+	displayBase := len(thresholds)
 	displayItems := []struct {
 		label string
 		value string
 		idx   int
 	}{
-		{"Chart Type:", s.ChartType, 4},
-		{"View Type:", viewName, 5},
-		{"Sort By:", s.SortBy, 6},
-		{"History Length:", fmt.Sprintf("%ds", s.HistoryLength), 7},
+		{"Chart Type:", s.ChartType, displayBase},
+		{"View Type:", viewName, displayBase + 1},
+		{"Sort By:", s.SortBy, displayBase + 2},
+		{"History Length:", fmt.Sprintf("%ds", s.HistoryLength), displayBase + 3},
 	}
 
 	for _, item := range displayItems {
@@ -82,8 +83,8 @@ func RenderSettingsOverlay(s *data.AppState, width, height int, b, p, t, mu, bg
 	var col2 []string
 	col2 = append(col2, headerStyle.Render("TABS & APPEARANCE"))
 
-	allTabs := []string{"Overview", "Metrics", "Processes", "Disks", "Network", "System"}
-	currentTabIdxBase := 8
+	allTabs := config.AllTabs()
+	currentTabIdxBase := displayBase + len(displayItems)
 
 	for i, tabName := range allTabs {
 		idx := currentTabIdxBase + i
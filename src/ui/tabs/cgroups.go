@@ -0,0 +1,174 @@
+package tabs
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/ui/widgets"
+	"github.com/N1xev/bubbleMonitor/src/utils"
+)
+
+// RenderCgroups renders the cgroup v2 hierarchy as a collapsible tree
+func RenderCgroups(s *data.AppState, container lipgloss.Style, su, w, a, t, mu, p, b compat.AdaptiveColor, availHeight int) string {
+	boxWidth := s.Width
+	contentWidth := boxWidth - 4
+	border := widgets.GetBorder(s.BorderStyle, s.BorderType)
+
+	contentHeight := availHeight - 2
+	if contentHeight < 0 {
+		contentHeight = 0
+	}
+
+	render := func(title, content string) string {
+		c := container.Width(boxWidth).Height(contentHeight).BorderTop(false)
+		body := c.Render(content)
+		topBorder := widgets.RenderTopBorderWithBg(title, boxWidth, border, b, p)
+		return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+	}
+
+	if s.CgroupsErr != "" {
+		msg := lipgloss.JoinVertical(lipgloss.Left,
+			lipgloss.NewStyle().Foreground(a).Bold(true).Render("Cannot read the cgroup hierarchy"),
+			"",
+			lipgloss.NewStyle().Foreground(t).Render(s.CgroupsErr),
+		)
+		return render("CGROUPS", msg)
+	}
+	if s.Cgroups == nil {
+		return render("CGROUPS", lipgloss.NewStyle().Foreground(mu).Render("Reading cgroups..."))
+	}
+
+	visible := s.GetVisibleCgroups()
+
+	cpuWidth := 8
+	memWidth := 21
+	ioWidth := 21
+	pidsWidth := 6
+	psiWidth := 17
+	// Drop the pressure and I/O columns on narrow terminals
+	showPSI := contentWidth >= 110
+	showIO := contentWidth >= 90
+	nameWidth := contentWidth - cpuWidth - memWidth - pidsWidth - 3
+	if showIO {
+		nameWidth -= ioWidth + 1
+	}
+	if showPSI {
+		nameWidth -= psiWidth + 1
+	}
+	if nameWidth < 20 {
+		nameWidth = 20
+	}
+
+	hdrStyle := lipgloss.NewStyle().Bold(true).Underline(true)
+	headerRow := hdrStyle.Width(nameWidth).Render("CGROUP") + " " +
+		hdrStyle.Width(cpuWidth).Align(lipgloss.Right).Render("CPU") + " " +
+		hdrStyle.Width(memWidth).Align(lipgloss.Right).Render("MEM / MAX") + " "
+	if showIO {
+		headerRow += hdrStyle.Width(ioWidth).Align(lipgloss.Right).Render("IO R / W") + " "
+	}
+	headerRow += hdrStyle.Width(pidsWidth).Align(lipgloss.Right).Render("PIDS")
+	if showPSI {
+		headerRow += " " + hdrStyle.Width(psiWidth).Align(lipgloss.Right).Render("PSI CPU MEM IO")
+	}
+
+	visibleRows := contentHeight - 2
+	if visibleRows < 1 {
+		visibleRows = 1
+	}
+	startIdx := s.CgroupScrollOffset
+	if startIdx > len(visible)-1 {
+		startIdx = 0
+	}
+	endIdx := startIdx + visibleRows
+	if endIdx > len(visible) {
+		endIdx = len(visible)
+	}
+
+	selColor := compat.AdaptiveColor{Light: lipgloss.Color("#E0E7FF"), Dark: lipgloss.Color("#3730A3")}
+
+	// PSI is the share of time tasks were stalled, so much lower values warrant attention than for usage
+	pressureColor := func(v float64) compat.AdaptiveColor {
+		switch {
+		case v >= 25:
+			return a
+		case v >= 5:
+			return w
+		case v > 0:
+			return su
+		}
+		return mu
+	}
+
+	var rows []string
+	for i := startIdx; i < endIdx; i++ {
+		node := visible[i]
+		isSelected := i == s.SelectedCgroup
+
+		cell := lipgloss.NewStyle()
+		if isSelected {
+			cell = cell.Background(selColor)
+		}
+
+		indicator := "  "
+		if node.HasChildren {
+			indicator = "▼ "
+			if s.CollapsedCgroups[node.Path] {
+				indicator = "▶ "
+			}
+		}
+		name := strings.Repeat("  ", node.Depth) + indicator + node.Name
+		if len(name) > nameWidth {
+			name = name[:nameWidth-3] + "..."
+		}
+
+		mem := "-"
+		if node.MemoryCurrent > 0 {
+			mem = utils.FormatBytes(node.MemoryCurrent) + " / "
+			if node.MemoryMax > 0 {
+				mem += utils.FormatBytes(node.MemoryMax)
+			} else {
+				mem += "max"
+			}
+		}
+		memColor := t
+		if node.MemoryMax > 0 {
+			memColor = widgets.GetColorForValue(float64(node.MemoryCurrent)/float64(node.MemoryMax)*100, su, w, a)
+		}
+
+		io := "-"
+		if node.IOReadRate >= 1 || node.IOWriteRate >= 1 {
+			io = formatIORate(node.IOReadRate) + " / " + formatIORate(node.IOWriteRate)
+		}
+
+		sp := cell.Render(" ")
+		row := cell.Bold(node.HasChildren).Width(nameWidth).Render(name) + sp +
+			cell.Foreground(widgets.GetColorForValue(node.CpuPercent, su, w, a)).Width(cpuWidth).Align(lipgloss.Right).Render(fmt.Sprintf("%.1f%%", node.CpuPercent)) + sp +
+			cell.Foreground(memColor).Width(memWidth).Align(lipgloss.Right).Render(mem) + sp
+		if showIO {
+			row += cell.Width(ioWidth).Align(lipgloss.Right).Render(io) + sp
+		}
+		row += cell.Width(pidsWidth).Align(lipgloss.Right).Render(fmt.Sprintf("%d", node.PidsCurrent))
+		if showPSI {
+			row += sp + cell.Foreground(pressureColor(node.CpuPressure)).Width(5).Align(lipgloss.Right).Render(fmt.Sprintf("%.1f", node.CpuPressure)) + sp +
+				cell.Foreground(pressureColor(node.MemoryPressure)).Width(5).Align(lipgloss.Right).Render(fmt.Sprintf("%.1f", node.MemoryPressure)) + sp +
+				cell.Foreground(pressureColor(node.IOPressure)).Width(5).Align(lipgloss.Right).Render(fmt.Sprintf("%.1f", node.IOPressure))
+		}
+
+		rows = append(rows, cell.Width(contentWidth).Render(row))
+	}
+
+	titleText := fmt.Sprintf("CGROUPS (%d)", len(s.Cgroups))
+	if len(visible) > visibleRows {
+		titleText += fmt.Sprintf(" [%d-%d of %d]", startIdx+1, endIdx, len(visible))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.NewStyle().Width(contentWidth).Render(headerRow),
+		strings.Join(rows, "\n"),
+	)
+	return render(titleText, content)
+}
@@ -0,0 +1,243 @@
+package tabs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/ui/widgets"
+)
+
+// RenderConnections renders the Connections view of the Network tab
+func RenderConnections(s *data.AppState, container lipgloss.Style, su, w, a, t, mu, p, b compat.AdaptiveColor, availHeight int) string {
+	boxWidth := s.Width
+	contentWidth := boxWidth - 4
+	border := widgets.GetBorder(s.BorderStyle, s.BorderType)
+
+	contentHeight := availHeight - 2
+	if contentHeight < 0 {
+		contentHeight = 0
+	}
+
+	render := func(title, content string) string {
+		c := container.Width(boxWidth).Height(contentHeight).BorderTop(false)
+		body := c.Render(content)
+		topBorder := widgets.RenderTopBorderWithBg(title, boxWidth, border, b, p)
+		return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+	}
+
+	if s.ConnectionsErr != "" {
+		msg := lipgloss.JoinVertical(lipgloss.Left,
+			lipgloss.NewStyle().Foreground(a).Bold(true).Render("Cannot list connections"),
+			"",
+			lipgloss.NewStyle().Foreground(t).Render(s.ConnectionsErr),
+		)
+		return render("CONNECTIONS", msg)
+	}
+	if s.Connections == nil {
+		return render("CONNECTIONS", lipgloss.NewStyle().Foreground(mu).Render("Loading connections..."))
+	}
+
+	// Connection count per state, most common first
+	counts := make(map[string]int)
+	for _, c := range s.Connections {
+		counts[c.State]++
+	}
+	states := make([]string, 0, len(counts))
+	for state := range counts {
+		states = append(states, state)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		if counts[states[i]] != counts[states[j]] {
+			return counts[states[i]] > counts[states[j]]
+		}
+		return states[i] < states[j]
+	})
+	var summary []string
+	for _, state := range states {
+		summary = append(summary, lipgloss.NewStyle().Foreground(stateColor(state, su, w, a, t)).Render(state)+
+			lipgloss.NewStyle().Foreground(mu).Render(fmt.Sprintf(" %d", counts[state])))
+	}
+	summaryLine := lipgloss.NewStyle().Width(contentWidth).MaxHeight(1).Render(strings.Join(summary, "  "))
+
+	// Filter line: the query being typed, the active query or a hint
+	var filterLine string
+	switch {
+	case s.ConnFilterMode:
+		filterLine = lipgloss.NewStyle().Foreground(p).Bold(true).Render("Filter: ") +
+			lipgloss.NewStyle().Foreground(t).Render(s.ConnFilter+"█")
+	case s.ConnFilter != "":
+		filterLine = lipgloss.NewStyle().Foreground(p).Bold(true).Render("Filter: ") +
+			lipgloss.NewStyle().Foreground(t).Render(s.ConnFilter)
+	default:
+		filterLine = lipgloss.NewStyle().Foreground(mu).Italic(true).Render(`f to filter, e.g. state:listen port:443 proc:nginx !proto:unix`)
+	}
+	if s.ConnFilterError != "" {
+		filterLine += lipgloss.NewStyle().Foreground(a).Render("  " + s.ConnFilterError)
+	}
+	filterLine = lipgloss.NewStyle().Width(contentWidth).MaxHeight(1).Render(filterLine)
+
+	conns := s.GetFilteredConnections()
+
+	protoWidth := 5
+	stateWidth := 13
+	queueWidth := 7
+	rateWidth := 10
+	pidWidth := 7
+	procWidth := 15
+	userWidth := 10
+	showUser := true
+	showRates := s.SocketTraffic.Available
+	showQueues := true
+
+	// Optional columns are dropped, least useful first, until both
+	// addresses get a readable width
+	addrWidthFor := func() int {
+		fixed := protoWidth + stateWidth + pidWidth + procWidth + 5
+		if showQueues {
+			fixed += 2 * (queueWidth + 1)
+		}
+		if showRates {
+			fixed += 2 * (rateWidth + 1)
+		}
+		if showUser {
+			fixed += userWidth + 1
+		}
+		return (contentWidth - fixed) / 2
+	}
+	addrWidth := addrWidthFor()
+	for _, drop := range []*bool{&showUser, &showQueues, &showRates} {
+		if addrWidth >= 18 {
+			break
+		}
+		*drop = false
+		addrWidth = addrWidthFor()
+	}
+	if addrWidth < 10 {
+		addrWidth = 10
+	}
+
+	hdrStyle := lipgloss.NewStyle().Bold(true).Underline(true)
+	headerRow := hdrStyle.Width(protoWidth).Render("PROTO") + " " +
+		hdrStyle.Width(stateWidth).Render("STATE") + " " +
+		hdrStyle.Width(addrWidth).Render("LOCAL") + " " +
+		hdrStyle.Width(addrWidth).Render("REMOTE") + " "
+	if showQueues {
+		headerRow += hdrStyle.Width(queueWidth).Align(lipgloss.Right).Render("SEND-Q") + " " +
+			hdrStyle.Width(queueWidth).Align(lipgloss.Right).Render("RECV-Q") + " "
+	}
+	if showRates {
+		headerRow += hdrStyle.Width(rateWidth).Align(lipgloss.Right).Render("RX") + " " +
+			hdrStyle.Width(rateWidth).Align(lipgloss.Right).Render("TX") + " "
+	}
+	headerRow += hdrStyle.Width(pidWidth).Align(lipgloss.Right).Render("PID") + " " +
+		hdrStyle.Width(procWidth).Render("PROCESS")
+	if showUser {
+		headerRow += " " + hdrStyle.Width(userWidth).Render("USER")
+	}
+
+	visibleRows := contentHeight - 4
+	if visibleRows < 1 {
+		visibleRows = 1
+	}
+	startIdx := s.ConnScrollOffset
+	if startIdx > len(conns)-1 {
+		startIdx = 0
+	}
+	endIdx := startIdx + visibleRows
+	if endIdx > len(conns) {
+		endIdx = len(conns)
+	}
+
+	selColor := compat.AdaptiveColor{Light: lipgloss.Color("#E0E7FF"), Dark: lipgloss.Color("#3730A3")}
+	// Addresses keep their tail, where the port is
+	truncLeft := func(str string, width int) string {
+		if r := []rune(str); len(r) > width-1 {
+			return "…" + string(r[len(r)-width+2:])
+		}
+		return str
+	}
+	trunc := func(str string, width int) string {
+		if len(str) > width-1 {
+			return str[:width-2] + "…"
+		}
+		return str
+	}
+
+	var rows []string
+	for i := startIdx; i < endIdx; i++ {
+		c := conns[i]
+		cell := lipgloss.NewStyle()
+		if i == s.SelectedConnection {
+			cell = cell.Background(selColor)
+		}
+
+		pid, proc := "-", "-"
+		if c.Pid > 0 {
+			pid = fmt.Sprintf("%d", c.Pid)
+			proc = c.Process
+		}
+		queueColor := func(q uint64) compat.AdaptiveColor {
+			if q > 0 {
+				return w
+			}
+			return mu
+		}
+
+		sp := cell.Render(" ")
+		row := cell.Foreground(mu).Width(protoWidth).Render(c.Proto) + sp +
+			cell.Foreground(stateColor(c.State, su, w, a, t)).Width(stateWidth).Render(trunc(c.State, stateWidth)) + sp +
+			cell.Width(addrWidth).Render(truncLeft(c.LocalAddr, addrWidth)) + sp +
+			cell.Width(addrWidth).Render(truncLeft(c.RemoteAddr, addrWidth)) + sp
+		if showQueues {
+			row += cell.Foreground(queueColor(c.TxQueue)).Width(queueWidth).Align(lipgloss.Right).Render(fmt.Sprintf("%d", c.TxQueue)) + sp +
+				cell.Foreground(queueColor(c.RxQueue)).Width(queueWidth).Align(lipgloss.Right).Render(fmt.Sprintf("%d", c.RxQueue)) + sp
+		}
+		if showRates {
+			row += cell.Width(rateWidth).Align(lipgloss.Right).Render(formatNetRate(s, c.RxRate)) + sp +
+				cell.Width(rateWidth).Align(lipgloss.Right).Render(formatNetRate(s, c.TxRate)) + sp
+		}
+		row += cell.Width(pidWidth).Align(lipgloss.Right).Render(pid) + sp +
+			cell.Bold(true).Width(procWidth).Render(trunc(proc, procWidth))
+		if showUser {
+			row += sp + cell.Foreground(mu).Width(userWidth).Render(trunc(c.User, userWidth))
+		}
+		rows = append(rows, cell.Width(contentWidth).Render(row))
+	}
+
+	titleText := fmt.Sprintf("CONNECTIONS (%d sockets)", len(s.Connections))
+	if s.ConnQuery != nil {
+		titleText = fmt.Sprintf("CONNECTIONS (%d of %d sockets)", len(conns), len(s.Connections))
+	}
+	if len(conns) > visibleRows {
+		titleText += fmt.Sprintf(" [%d-%d of %d]", startIdx+1, endIdx, len(conns))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		summaryLine,
+		filterLine,
+		lipgloss.NewStyle().Width(contentWidth).Render(headerRow),
+		strings.Join(rows, "\n"),
+	)
+	return render(titleText, content)
+}
+
+// stateColor colors a socket state: established green, listening in the
+// text color, half-open handshakes and CLOSE_WAIT (usually a peer the
+// application never closed) red, and other transient states yellow
+func stateColor(state string, su, w, a, t compat.AdaptiveColor) compat.AdaptiveColor {
+	switch state {
+	case "ESTABLISHED", "CONNECTED":
+		return su
+	case "LISTEN", "UNCONN":
+		return t
+	case "CLOSE_WAIT", "SYN_SENT", "SYN_RECV":
+		return a
+	default:
+		return w
+	}
+}
@@ -0,0 +1,165 @@
+package tabs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/ui/widgets"
+	"github.com/N1xev/bubbleMonitor/src/utils"
+)
+
+// RenderContainers renders the containers tab
+func RenderContainers(s *data.AppState, container lipgloss.Style, su, w, a, t, mu, p, b compat.AdaptiveColor, availHeight int) string {
+	boxWidth := s.Width
+	contentWidth := boxWidth - 4
+	border := widgets.GetBorder(s.BorderStyle, s.BorderType)
+
+	contentHeight := availHeight - 2
+	if contentHeight < 0 {
+		contentHeight = 0
+	}
+
+	render := func(title, content string) string {
+		c := container.Width(boxWidth).Height(contentHeight).BorderTop(false)
+		body := c.Render(content)
+		topBorder := widgets.RenderTopBorderWithBg(title, boxWidth, border, b, p)
+		return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+	}
+
+	if s.ContainersErr != "" {
+		msg := lipgloss.JoinVertical(lipgloss.Left,
+			lipgloss.NewStyle().Foreground(a).Bold(true).Render("Cannot reach the container API"),
+			"",
+			lipgloss.NewStyle().Foreground(t).Render(s.ContainersErr),
+			"",
+			lipgloss.NewStyle().Foreground(mu).Render("Set \"docker_socket\" in the config to the Docker or Podman socket (or an http:// URL)."),
+		)
+		return render("CONTAINERS", msg)
+	}
+	if s.Containers == nil {
+		return render("CONTAINERS", lipgloss.NewStyle().Foreground(mu).Render("Loading containers..."))
+	}
+	if len(s.Containers) == 0 {
+		return render("CONTAINERS", lipgloss.NewStyle().Foreground(mu).Render("No containers"))
+	}
+
+	stateWidth := 11
+	uptimeWidth := 12
+	cpuWidth := 8
+	memWidth := 20
+	netWidth := 20
+	blockWidth := 20
+	restartWidth := 4
+	rest := contentWidth - stateWidth - uptimeWidth - cpuWidth - memWidth - netWidth - blockWidth - restartWidth - 8
+	nameWidth := rest / 2
+	imageWidth := rest - nameWidth
+	if nameWidth < 12 {
+		nameWidth = 12
+	}
+	if imageWidth < 12 {
+		imageWidth = 12
+	}
+
+	hdrStyle := lipgloss.NewStyle().Bold(true).Underline(true)
+	headerRow := hdrStyle.Width(nameWidth).Render("NAME") + " " +
+		hdrStyle.Width(imageWidth).Render("IMAGE") + " " +
+		hdrStyle.Width(stateWidth).Render("STATE") + " " +
+		hdrStyle.Width(uptimeWidth).Render("UPTIME") + " " +
+		hdrStyle.Width(cpuWidth).Align(lipgloss.Right).Render("CPU") + " " +
+		hdrStyle.Width(memWidth).Align(lipgloss.Right).Render("MEM / LIMIT") + " " +
+		hdrStyle.Width(netWidth).Align(lipgloss.Right).Render("NET RX / TX") + " " +
+		hdrStyle.Width(blockWidth).Align(lipgloss.Right).Render("BLOCK R / W") + " " +
+		hdrStyle.Width(restartWidth).Align(lipgloss.Right).Render("RST")
+
+	visibleRows := contentHeight - 2
+	if visibleRows < 1 {
+		visibleRows = 1
+	}
+	startIdx := s.ContainerScrollOffset
+	if startIdx > len(s.Containers)-1 {
+		startIdx = 0
+	}
+	endIdx := startIdx + visibleRows
+	if endIdx > len(s.Containers) {
+		endIdx = len(s.Containers)
+	}
+
+	selColor := compat.AdaptiveColor{Light: lipgloss.Color("#E0E7FF"), Dark: lipgloss.Color("#3730A3")}
+	trunc := func(str string, width int) string {
+		if len(str) > width-1 {
+			return str[:width-2] + "…"
+		}
+		return str
+	}
+
+	var rows []string
+	for i := startIdx; i < endIdx; i++ {
+		ct := s.Containers[i]
+		isSelected := i == s.SelectedContainer
+
+		cell := lipgloss.NewStyle()
+		if isSelected {
+			cell = cell.Background(selColor)
+		}
+
+		stateColor := mu
+		switch ct.State {
+		case "running":
+			stateColor = su
+		case "paused", "restarting":
+			stateColor = w
+		case "dead":
+			stateColor = a
+		}
+
+		uptime := "-"
+		if ct.State == "running" && !ct.StartedAt.IsZero() {
+			uptime = utils.FormatDuration(time.Since(ct.StartedAt).Truncate(time.Minute))
+		}
+
+		cpu, mem, netIO, blockIO := "-", "-", "-", "-"
+		cpuColor := mu
+		if ct.State == "running" {
+			cpuColor = widgets.GetColorForValue(ct.CpuPercent, su, w, a)
+			cpu = fmt.Sprintf("%.1f%%", ct.CpuPercent)
+			mem = utils.FormatBytes(ct.MemUsage) + " / " + utils.FormatBytes(ct.MemLimit)
+			netIO = utils.FormatBytes(ct.NetRx) + " / " + utils.FormatBytes(ct.NetTx)
+			blockIO = utils.FormatBytes(ct.BlockRead) + " / " + utils.FormatBytes(ct.BlockWrite)
+		}
+
+		sp := cell.Render(" ")
+		row := cell.Bold(true).Width(nameWidth).Render(trunc(ct.Name, nameWidth)) + sp +
+			cell.Width(imageWidth).Render(trunc(ct.Image, imageWidth)) + sp +
+			cell.Foreground(stateColor).Width(stateWidth).Render(ct.State) + sp +
+			cell.Width(uptimeWidth).Render(trunc(uptime, uptimeWidth)) + sp +
+			cell.Foreground(cpuColor).Width(cpuWidth).Align(lipgloss.Right).Render(cpu) + sp +
+			cell.Width(memWidth).Align(lipgloss.Right).Render(mem) + sp +
+			cell.Width(netWidth).Align(lipgloss.Right).Render(netIO) + sp +
+			cell.Width(blockWidth).Align(lipgloss.Right).Render(blockIO) + sp +
+			cell.Width(restartWidth).Align(lipgloss.Right).Render(fmt.Sprintf("%d", ct.RestartCount))
+
+		rows = append(rows, cell.Width(contentWidth).Render(row))
+	}
+
+	running := 0
+	for _, ct := range s.Containers {
+		if ct.State == "running" {
+			running++
+		}
+	}
+	titleText := fmt.Sprintf("CONTAINERS (%d running, %d total)", running, len(s.Containers))
+	if len(s.Containers) > visibleRows {
+		titleText += fmt.Sprintf(" [%d-%d of %d]", startIdx+1, endIdx, len(s.Containers))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.NewStyle().Width(contentWidth).Render(headerRow),
+		strings.Join(rows, "\n"),
+	)
+	return render(titleText, content)
+}
@@ -2,6 +2,7 @@ package tabs
 
 import (
 	"fmt"
+	"strings"
 
 	"charm.land/lipgloss/v2"
 	"charm.land/lipgloss/v2/compat"
@@ -11,9 +12,9 @@ import (
 	"github.com/N1xev/bubbleMonitor/src/utils"
 )
 
-// RenderDisks renders the disk partitions tab
+// RenderDisks renders the disks tab: per-device I/O above the partitions
 func RenderDisks(s *data.AppState, container lipgloss.Style, su, w, a, t, mu, p, b compat.AdaptiveColor, availHeight int) string {
-	if len(s.DiskPartitions) == 0 {
+	if s.DiskPartitions == nil {
 		return "Loading disk information..."
 	}
 
@@ -29,25 +30,102 @@ func RenderDisks(s *data.AppState, container lipgloss.Style, su, w, a, t, mu, p,
 	mountStyle := lipgloss.NewStyle().Bold(true).Foreground(t)
 	infoStyle := lipgloss.NewStyle().Foreground(mu)
 
+	devices := make(map[string]data.DiskDevice, len(s.DiskDevices))
+	for _, dev := range s.DiskDevices {
+		devices[dev.Name] = dev
+	}
+
+	var devicesBox string
+	if len(s.DiskDevices) > 0 {
+		devicesBox = renderDiskDevices(s, contentWidth, su, w, a, t, mu, p)
+		c := container.Width(boxWidth).Height(len(s.DiskDevices) + 1).BorderTop(false)
+		titleText := fmt.Sprintf("DISK DEVICES (R: %.2f MB/s W: %.2f MB/s)", s.DiskReadRate, s.DiskWriteRate)
+		devicesBox = lipgloss.JoinVertical(lipgloss.Left,
+			widgets.RenderTopBorderWithBg(titleText, boxWidth, border, b, p),
+			c.Render(devicesBox),
+		)
+	}
+
+	// Options worth pointing out; the rest are shown plainly
+	notable := map[string]bool{"ro": true, "noexec": true, "nosuid": true, "nodev": true, "sync": true}
+
 	var diskBlocks []string
 	for _, d := range s.DiskPartitions {
+		// Mountpoint, device, filesystem and mount options
+		header := mountStyle.Render(d.Mountpoint) + infoStyle.Render("  "+d.Device+" • "+d.Fstype)
+		if len(d.Options) > 0 {
+			var opts []string
+			for _, opt := range d.Options {
+				if notable[opt] {
+					opts = append(opts, lipgloss.NewStyle().Foreground(w).Bold(true).Render(opt))
+				} else {
+					opts = append(opts, infoStyle.Render(opt))
+				}
+			}
+			header += infoStyle.Render(" • ") + strings.Join(opts, infoStyle.Render(","))
+		}
+		if len(d.BindMounts) > 0 {
+			header += infoStyle.Render("  (also " + strings.Join(d.BindMounts, ", ") + ")")
+		}
+		header = lipgloss.NewStyle().MaxWidth(contentWidth).Render(header)
+
+		if d.Err != "" {
+			msg := "⚠ " + d.Err
+			if d.Hung {
+				msg = "⚠ not responding: usage unavailable"
+			}
+			block := lipgloss.JoinVertical(lipgloss.Left,
+				fwLine(header),
+				fwLine(lipgloss.NewStyle().Foreground(a).Bold(true).Render(msg)),
+				fwLine(""),
+			)
+			diskBlocks = append(diskBlocks, block)
+			continue
+		}
+
 		bar := widgets.RenderProgressBar(d.UsedPct, contentWidth, su, w, a)
 
-		info := fmt.Sprintf("Used: %s / %s", utils.FormatBytes(d.Used), utils.FormatBytes(d.Total))
+		info := fmt.Sprintf("Used: %s / %s  Avail: %s", utils.FormatBytes(d.Used), utils.FormatBytes(d.Total), utils.FormatBytes(d.Available))
+		if d.Reserved > 0 {
+			info += "  Reserved: " + utils.FormatBytes(d.Reserved)
+		}
+		infoLine := infoStyle.Render(info)
+		if d.InodesTotal > 0 {
+			inodeStyle := infoStyle
+			if d.InodesPct >= 90 {
+				// Out of inodes means out of space, whatever the bytes say
+				inodeStyle = lipgloss.NewStyle().Foreground(widgets.GetColorForValue(d.InodesPct, su, w, a)).Bold(true)
+			}
+			infoLine += infoStyle.Render("  Inodes: ") + inodeStyle.Render(fmt.Sprintf("%.0f%%", d.InodesPct)) +
+				infoStyle.Render(fmt.Sprintf(" of %s", formatCountRate(float64(d.InodesTotal))))
+		}
+		// The activity of the device holding the partition
+		if dev, ok := devices[d.BlockDevice]; ok {
+			infoLine += infoStyle.Render(fmt.Sprintf("  •  %s R %s W %s %.0f%% util", dev.Name, formatIORate(dev.ReadRate), formatIORate(dev.WriteRate), dev.Util))
+		}
 
 		block := lipgloss.JoinVertical(lipgloss.Left,
-			fwLine(mountStyle.Render(d.Mountpoint)),
+			fwLine(header),
 			fwLine(bar),
-			fwLine(infoStyle.Render(info)),
+			fwLine(lipgloss.NewStyle().MaxWidth(contentWidth).Render(infoLine)),
 		)
 		diskBlocks = append(diskBlocks, block)
 	}
 
 	content := lipgloss.JoinVertical(lipgloss.Left, diskBlocks...)
+	if len(diskBlocks) == 0 {
+		content = infoStyle.Render(`No partitions match the "disks" include and exclude rules`)
+	}
 
-	titleText := fmt.Sprintf("DISK PARTITIONS (Total R: %.2f MB/s W: %.2f MB/s)", s.DiskReadRate, s.DiskWriteRate)
+	titleText := "DISK PARTITIONS"
+	if sortBy := s.Config.Disks.SortBy; sortBy != "" {
+		titleText = fmt.Sprintf("DISK PARTITIONS (by %s)", sortBy)
+	}
 
 	contentHeight := availHeight - 2
+	if devicesBox != "" {
+		contentHeight -= lipgloss.Height(devicesBox)
+	}
 	if contentHeight < 0 {
 		contentHeight = 0
 	}
@@ -56,5 +134,81 @@ func RenderDisks(s *data.AppState, container lipgloss.Style, su, w, a, t, mu, p,
 	body := c.Render(content)
 	topBorder := widgets.RenderTopBorderWithBg(titleText, boxWidth, border, b, p)
 
-	return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+	return lipgloss.JoinVertical(lipgloss.Left, devicesBox, topBorder, body)
+}
+
+// renderDiskDevices renders one row per whole device with its IOPS,
+// throughput, average latency, queue depth and utilization
+func renderDiskDevices(s *data.AppState, contentWidth int, su, w, a, t, mu, p compat.AdaptiveColor) string {
+	nameWidth := 10
+	numWidth := 8
+	rateWidth := 10
+	utilWidth := 6
+	fixed := nameWidth + 4*numWidth + 2*rateWidth + numWidth + utilWidth + 8
+	histWidth := contentWidth - fixed - 1
+	showHistory := histWidth >= 10
+
+	hdrStyle := lipgloss.NewStyle().Bold(true).Underline(true)
+	right := func(width int, str string) string {
+		return hdrStyle.Width(width).Align(lipgloss.Right).Render(str)
+	}
+	headerRow := hdrStyle.Width(nameWidth).Render("DEVICE") + " " +
+		right(numWidth, "R IOPS") + " " +
+		right(numWidth, "W IOPS") + " " +
+		right(rateWidth, "READ") + " " +
+		right(rateWidth, "WRITE") + " " +
+		right(numWidth, "R LAT") + " " +
+		right(numWidth, "W LAT") + " " +
+		right(numWidth, "QUEUE") + " " +
+		right(utilWidth, "UTIL")
+	if showHistory {
+		headerRow += " " + hdrStyle.Width(histWidth).Render("UTIL HISTORY")
+	}
+
+	textStyle := lipgloss.NewStyle().Foreground(t)
+	mutedStyle := lipgloss.NewStyle().Foreground(mu)
+	trunc := func(str string, width int) string {
+		if len(str) > width-1 {
+			return str[:width-2] + "…"
+		}
+		return str
+	}
+	latency := func(ms float64) string {
+		if ms <= 0 {
+			return "-"
+		}
+		if ms < 10 {
+			return fmt.Sprintf("%.1fms", ms)
+		}
+		return fmt.Sprintf("%.0fms", ms)
+	}
+
+	rows := []string{headerRow}
+	for _, dev := range s.DiskDevices {
+		nameStyle := textStyle.Bold(true)
+		if dev.Stacked {
+			// Its I/O is also counted on the devices below it
+			nameStyle = mutedStyle
+		}
+		queue := "-"
+		if dev.QueueDepth > 0 {
+			queue = fmt.Sprintf("%d", dev.QueueDepth)
+		}
+		utilColor := widgets.GetColorForValue(dev.Util, su, w, a)
+
+		row := nameStyle.Width(nameWidth).Render(trunc(dev.Name, nameWidth)) + " " +
+			textStyle.Width(numWidth).Align(lipgloss.Right).Render(formatCountRate(dev.ReadIOPS)) + " " +
+			textStyle.Width(numWidth).Align(lipgloss.Right).Render(formatCountRate(dev.WriteIOPS)) + " " +
+			textStyle.Width(rateWidth).Align(lipgloss.Right).Render(formatIORate(dev.ReadRate)) + " " +
+			textStyle.Width(rateWidth).Align(lipgloss.Right).Render(formatIORate(dev.WriteRate)) + " " +
+			mutedStyle.Width(numWidth).Align(lipgloss.Right).Render(latency(dev.ReadLatency)) + " " +
+			mutedStyle.Width(numWidth).Align(lipgloss.Right).Render(latency(dev.WriteLatency)) + " " +
+			textStyle.Width(numWidth).Align(lipgloss.Right).Render(queue) + " " +
+			lipgloss.NewStyle().Foreground(utilColor).Bold(true).Width(utilWidth).Align(lipgloss.Right).Render(fmt.Sprintf("%.0f%%", dev.Util))
+		if hist := s.DiskUtilHistory[dev.Name]; showHistory && hist != nil && hist.Len() > 0 {
+			row += " " + widgets.RenderSparkline(&ScaledAccessor{A: hist, Scale: 100}, histWidth, 1, p, a)
+		}
+		rows = append(rows, row)
+	}
+	return strings.Join(rows, "\n")
 }
@@ -1,15 +1,154 @@
 package tabs
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/utils"
 )
 
-// CalcNetPercent calculates network usage percentage
+// netAxisWidth is the width of the scale label left of a network chart
+const netAxisWidth = 6
+
+// ScaledAccessor presents a rate history as a percentage of a fixed scale,
+// so charts draw it against that scale rather than their own peak
+type ScaledAccessor struct {
+	A     data.Accessor
+	Scale float64
+}
+
+func (s *ScaledAccessor) Len() int {
+	return s.A.Len()
+}
+
+func (s *ScaledAccessor) Get(i int) float64 {
+	if s.Scale <= 0 {
+		return 0
+	}
+	return min(s.A.Get(i)/s.Scale*100, 100)
+}
+
+func (s *ScaledAccessor) Bound() float64 {
+	return 100
+}
+
+// CalcNetPercent returns the busier direction's current rate as a
+// percentage of the network chart scale
 func CalcNetPercent(s *data.AppState) float64 {
-	total := s.NetSentRate + s.NetRecvRate
-	netP := (total / 10) * 100
-	if netP > 100 {
-		netP = 100
+	scale := netScale(s, linkCapacity(s), s.NetRxHistory, s.NetTxHistory)
+	return min(max(s.NetRecvRate, s.NetSentRate)/scale*100, 100)
+}
+
+// linkCapacity returns the summed speed of the physical links that are up,
+// in bytes/s per direction, or 0 when none reports a speed
+func linkCapacity(s *data.AppState) float64 {
+	mbps := 0
+	for _, info := range s.InterfaceDetails {
+		if !info.Loopback && info.Speed > 0 && info.LinkState() == "up" {
+			mbps += info.Speed
+		}
+	}
+	return float64(mbps) * 1e6 / 8
+}
+
+// netScale returns the full scale of a network chart in bytes/s: the link
+// capacity when net_scale is "link" and the speed is known, otherwise the
+// peak of the histories rounded up to a round number
+func netScale(s *data.AppState, capacity float64, hists ...data.Accessor) float64 {
+	if s.Config.NetScale == "link" && capacity > 0 {
+		return capacity
+	}
+	peak := 0.0
+	for _, h := range hists {
+		if h == nil {
+			continue
+		}
+		for i := 0; i < h.Len(); i++ {
+			peak = max(peak, h.Get(i))
+		}
+	}
+	return niceRateScale(peak, netBits(s))
+}
+
+// netBits reports whether network rates are shown in bits per second
+func netBits(s *data.AppState) bool {
+	return s.Config.NetUnits == "bits"
+}
+
+// niceRateScale rounds a rate in bytes/s up to 1, 2 or 5 times a power of
+// ten of the display unit, with 1 K as the smallest scale
+func niceRateScale(rate float64, bits bool) float64 {
+	v, base := rate, 1024.0
+	if bits {
+		v, base = rate*8, 1000
+	}
+	unit := base
+	for v/unit >= base {
+		unit *= base
+	}
+	nice := base
+	for _, step := range []float64{1, 2, 5, 10, 20, 50, 100, 200, 500} {
+		if v/unit <= step {
+			nice = step
+			break
+		}
+	}
+	if bits {
+		return nice * unit / 8
+	}
+	return nice * unit
+}
+
+// formatNetRate formats a rate in bytes/s in the configured unit
+func formatNetRate(s *data.AppState, rate float64) string {
+	if rate < 1 {
+		return "-"
+	}
+	if netBits(s) {
+		return utils.FormatBits(rate*8) + "/s"
+	}
+	return utils.FormatBytes(uint64(rate)) + "/s"
+}
+
+// scaleLabel formats a chart scale compactly for its axis, e.g. 5MB or 1Gb
+func scaleLabel(scale float64, bits bool) string {
+	v, base, suffix := scale, 1024.0, "B"
+	if bits {
+		v, base, suffix = scale*8, 1000, "b"
+	}
+	prefixes := "KMGTP"
+	exp := -1
+	for v >= base && exp < len(prefixes)-1 {
+		v /= base
+		exp++
+	}
+	prefix := ""
+	if exp >= 0 {
+		prefix = string(prefixes[exp])
+	}
+	if v >= 10 {
+		return fmt.Sprintf("%.0f%s%s", v, prefix, suffix)
+	}
+	return fmt.Sprintf("%.3g%s%s", v, prefix, suffix)
+}
+
+// renderRateChart draws a rate history against a fixed scale, labelling the
+// top of the chart with the scale and the bottom with zero
+func renderRateChart(chart func(data.Accessor, int, int) string, hist data.Accessor, scale float64, bits bool, width, height int) string {
+	if hist == nil || hist.Len() == 0 {
+		return ""
+	}
+	lines := strings.Split(chart(&ScaledAccessor{A: hist, Scale: scale}, width-netAxisWidth, height), "\n")
+	for i := range lines {
+		label := ""
+		switch {
+		case i == 0:
+			label = scaleLabel(scale, bits)
+		case i == len(lines)-1:
+			label = "0"
+		}
+		lines[i] = fmt.Sprintf("%*s ", netAxisWidth-1, label) + lines[i]
 	}
-	return netP
+	return strings.Join(lines, "\n")
 }
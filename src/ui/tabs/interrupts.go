@@ -0,0 +1,231 @@
+package tabs
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/ui/widgets"
+)
+
+const (
+	// An IRQ is flagged as imbalanced when it fires at least irqHotRate
+	// times per second and one CPU takes irqImbalanceShare percent of it
+	irqHotRate        = 1000
+	irqImbalanceShare = 90
+)
+
+// renderInterrupts renders the interrupts view of the Metrics tab: hardware
+// IRQs busiest first, then softirqs, with their per-CPU rates
+func renderInterrupts(app *data.AppState, container lipgloss.Style, su, w, a, t, mu, p, b compat.AdaptiveColor, availHeight int) string {
+	boxWidth := app.Width
+	contentWidth := boxWidth - 4
+	border := widgets.GetBorder(app.BorderStyle, app.BorderType)
+
+	render := func(title, content string, height int) string {
+		c := container.Width(boxWidth).Height(height).BorderTop(false)
+		body := c.Render(content)
+		topBorder := widgets.RenderTopBorderWithBg(title, boxWidth, border, b, p)
+		return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+	}
+
+	info := app.Interrupts
+	if !info.Available {
+		msg := "Loading interrupts..."
+		if !info.Time.IsZero() {
+			msg = "Interrupt counters are only available on Linux (/proc/interrupts)"
+		}
+		return render("INTERRUPTS", lipgloss.NewStyle().Foreground(mu).Render(msg), availHeight-2)
+	}
+
+	var hardware, softirqs []data.InterruptSource
+	for _, src := range info.Sources {
+		if src.Softirq {
+			softirqs = append(softirqs, src)
+		} else {
+			hardware = append(hardware, src)
+		}
+	}
+
+	// Per-CPU distribution: a column per CPU when they fit, otherwise one
+	// shaded cell per CPU, otherwise only the busiest CPU
+	nameWidth := 9
+	rateWidth := 9
+	busiestWidth := 11
+	cpuWidth := 8
+	deviceMin := 16
+	fixed := nameWidth + rateWidth + busiestWidth + 3
+	cpuMode := "columns"
+	distWidth := info.CPUs * cpuWidth
+	if fixed+distWidth+deviceMin+1 > contentWidth {
+		cpuMode = "strip"
+		distWidth = info.CPUs
+		if fixed+distWidth+deviceMin+1 > contentWidth || info.CPUs < 2 {
+			cpuMode = ""
+			distWidth = 0
+		}
+	}
+	deviceWidth := contentWidth - fixed - distWidth - 1
+	if distWidth == 0 {
+		deviceWidth = contentWidth - fixed
+	}
+
+	hdrStyle := lipgloss.NewStyle().Bold(true).Underline(true)
+	header := func(first string, withDevice bool) string {
+		row := hdrStyle.Width(nameWidth).Render(first) + " " +
+			hdrStyle.Width(rateWidth).Align(lipgloss.Right).Render("RATE/s") + " " +
+			hdrStyle.Width(busiestWidth).Align(lipgloss.Right).Render("BUSIEST") + " "
+		switch cpuMode {
+		case "columns":
+			for cpu := 0; cpu < info.CPUs; cpu++ {
+				row += hdrStyle.Width(cpuWidth).Align(lipgloss.Right).Render(fmt.Sprintf("CPU%d", cpu))
+			}
+			row += " "
+		case "strip":
+			row += hdrStyle.Width(distWidth).Render("CPUS") + " "
+		}
+		if withDevice {
+			row += hdrStyle.Width(deviceWidth).Render("DEVICE")
+		}
+		return row
+	}
+
+	shades := []string{"░", "▒", "▓", "█"}
+	trunc := func(str string, width int) string {
+		if len(str) > width-1 {
+			return str[:width-2] + "…"
+		}
+		return str
+	}
+	textStyle := lipgloss.NewStyle().Foreground(t)
+
+	renderRow := func(src data.InterruptSource, withDevice bool, bold bool) string {
+		imbalanced := info.CPUs > 1 && src.Total >= irqHotRate && src.BusiestShare >= irqImbalanceShare
+		nameStyle := textStyle.Bold(bold)
+		busiestStyle := lipgloss.NewStyle().Foreground(mu)
+		if imbalanced {
+			nameStyle = nameStyle.Foreground(w).Bold(true)
+			busiestStyle = busiestStyle.Foreground(a).Bold(true)
+		}
+		busiest := "-"
+		if src.Total > 0 && info.CPUs > 1 {
+			busiest = fmt.Sprintf("cpu%d %3.0f%%", src.Busiest, src.BusiestShare)
+		}
+
+		row := nameStyle.Width(nameWidth).Render(trunc(src.Name, nameWidth)) + " " +
+			textStyle.Bold(bold).Width(rateWidth).Align(lipgloss.Right).Render(formatCountRate(src.Total)) + " " +
+			busiestStyle.Width(busiestWidth).Align(lipgloss.Right).Render(busiest) + " "
+		switch cpuMode {
+		case "columns":
+			for _, rate := range src.Rates {
+				color := mu
+				if rate >= 1 {
+					color = t
+				}
+				row += lipgloss.NewStyle().Foreground(color).Width(cpuWidth).Align(lipgloss.Right).Render(formatCountRate(rate))
+			}
+			row += strings.Repeat(" ", (info.CPUs-len(src.Rates))*cpuWidth) + " "
+		case "strip":
+			// Shade by the CPU's rate relative to the busiest, color by its share
+			peak := src.Rates[src.Busiest]
+			var strip strings.Builder
+			for _, rate := range src.Rates {
+				if rate < 1 || peak == 0 {
+					strip.WriteString(lipgloss.NewStyle().Foreground(mu).Render("·"))
+					continue
+				}
+				idx := int(rate / peak * float64(len(shades)-1))
+				strip.WriteString(lipgloss.NewStyle().Foreground(widgets.GetColorForValue(rate/src.Total*100, su, w, a)).Render(shades[idx]))
+			}
+			row += lipgloss.NewStyle().Width(distWidth).Render(strip.String()) + " "
+		}
+		if withDevice {
+			row += lipgloss.NewStyle().Foreground(mu).Width(deviceWidth).Render(trunc(src.Device, deviceWidth))
+		}
+		return row
+	}
+
+	// Per-CPU totals of the hardware IRQs show how evenly the load spreads
+	all := data.InterruptSource{Name: "ALL", Device: "all hardware interrupts", Rates: make([]float64, info.CPUs)}
+	for _, src := range hardware {
+		for cpu, rate := range src.Rates {
+			if cpu < len(all.Rates) {
+				all.Rates[cpu] += rate
+			}
+		}
+		all.Total += src.Total
+	}
+	for cpu, rate := range all.Rates {
+		if rate > all.Rates[all.Busiest] {
+			all.Busiest = cpu
+		}
+	}
+	if all.Total > 0 {
+		all.BusiestShare = all.Rates[all.Busiest] / all.Total * 100
+	}
+
+	// Softirqs get a fixed box below, hardware IRQs scroll in the rest
+	softHeight := len(softirqs) + 2
+	if softHeight > availHeight/2 {
+		softHeight = availHeight / 2
+	}
+	hardHeight := availHeight - softHeight - 3
+	if hardHeight < 4 {
+		hardHeight = 4
+	}
+
+	visibleRows := hardHeight - 3
+	if visibleRows < 1 {
+		visibleRows = 1
+	}
+	startIdx := app.InterruptScrollOffset
+	if startIdx > len(hardware)-visibleRows {
+		startIdx = len(hardware) - visibleRows
+	}
+	if startIdx < 0 {
+		startIdx = 0
+	}
+	endIdx := startIdx + visibleRows
+	if endIdx > len(hardware) {
+		endIdx = len(hardware)
+	}
+
+	hardRows := []string{header("IRQ", true), renderRow(all, true, true)}
+	for _, src := range hardware[startIdx:endIdx] {
+		hardRows = append(hardRows, renderRow(src, true, false))
+	}
+	hardTitle := fmt.Sprintf("HARDWARE INTERRUPTS (%d sources, %d CPUs)", len(hardware), info.CPUs)
+	if len(hardware) > visibleRows {
+		hardTitle += fmt.Sprintf(" [%d-%d of %d]", startIdx+1, endIdx, len(hardware))
+	}
+
+	softRows := []string{header("SOFTIRQ", false)}
+	for i, src := range softirqs {
+		if i >= softHeight-2 {
+			break
+		}
+		softRows = append(softRows, renderRow(src, false, false))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		render(hardTitle, strings.Join(hardRows, "\n"), hardHeight),
+		render("SOFTIRQS", strings.Join(softRows, "\n"), softHeight),
+	)
+}
+
+// formatCountRate formats an event rate compactly, e.g. 950, 12.3k, 1.2M
+func formatCountRate(rate float64) string {
+	switch {
+	case rate < 1:
+		return "-"
+	case rate < 1000:
+		return fmt.Sprintf("%.0f", rate)
+	case rate < 1000000:
+		return fmt.Sprintf("%.1fk", rate/1000)
+	default:
+		return fmt.Sprintf("%.1fM", rate/1000000)
+	}
+}
@@ -0,0 +1,185 @@
+package tabs
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/ui/widgets"
+	"github.com/N1xev/bubbleMonitor/src/utils"
+)
+
+// RenderMemory renders the memory breakdown tab
+func RenderMemory(s *data.AppState, container lipgloss.Style, su, w, a, t, mu, p, b compat.AdaptiveColor, availHeight int) string {
+	border := widgets.GetBorder(s.BorderStyle, s.BorderType)
+
+	render := func(title, content string, boxWidth, height int) string {
+		if height < 1 {
+			height = 1
+		}
+		c := container.Width(boxWidth).Height(height).BorderTop(false)
+		body := c.Render(content)
+		topBorder := widgets.RenderTopBorderWithBg(title, boxWidth, border, b, p)
+		return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+	}
+
+	vm := s.MemInfo
+	if vm == nil || vm.Total == 0 {
+		return render("MEMORY", lipgloss.NewStyle().Foreground(mu).Render("Loading memory info..."), s.Width, availHeight-2)
+	}
+
+	labelStyle := lipgloss.NewStyle().Foreground(mu)
+	valueStyle := lipgloss.NewStyle().Foreground(t).Bold(true)
+	textStyle := lipgloss.NewStyle().Foreground(t)
+
+	twoCols := s.Width >= 100
+	leftWidth := s.Width
+	rightWidth := s.Width
+	if twoCols {
+		widths := utils.CalculateColumnWidths(s.Width, 2)
+		leftWidth, rightWidth = widths[0], widths[1]
+	}
+
+	// Breakdown: label, share of total as a bar, amount
+	labelWidth := 14
+	amountWidth := 10
+	barWidth := leftWidth - 4 - labelWidth - amountWidth - 2
+	if barWidth < 5 {
+		barWidth = 5
+	}
+	pct := func(v uint64) float64 {
+		return float64(v) / float64(vm.Total) * 100
+	}
+	barRow := func(label string, v uint64) string {
+		return labelStyle.Width(labelWidth).Render(label) + " " +
+			widgets.RenderProgressBar(pct(v), barWidth, su, w, a) + " " +
+			valueStyle.Width(amountWidth).Align(lipgloss.Right).Render(utils.FormatBytes(v))
+	}
+	textRow := func(label, value string) string {
+		return labelStyle.Width(labelWidth).Render(label) + " " + textStyle.Render(value)
+	}
+
+	rows := []string{
+		textRow("Total", utils.FormatBytes(vm.Total)+labelStyle.Render(" ("+utils.FormatBytes(vm.Available)+" available)")),
+		barRow("Used", vm.Used),
+		barRow("Buffers", vm.Buffers),
+		barRow("Cached", vm.Cached),
+		barRow("Shared", vm.Shared),
+		barRow("Slab", vm.Slab),
+		textRow("", fmt.Sprintf("%s reclaimable, %s unreclaimable", utils.FormatBytes(vm.Sreclaimable), utils.FormatBytes(vm.Sunreclaim))),
+		textRow("Dirty", utils.FormatBytes(vm.Dirty)+labelStyle.Render("  writeback ")+utils.FormatBytes(vm.WriteBack)),
+		textRow("Swap cached", utils.FormatBytes(vm.SwapCached)),
+	}
+
+	if vm.HugePagesTotal > 0 {
+		rows = append(rows, textRow("Huge pages", fmt.Sprintf("%d × %s, %d free", vm.HugePagesTotal, utils.FormatBytes(vm.HugePageSize), vm.HugePagesFree)))
+	} else {
+		rows = append(rows, textRow("Huge pages", labelStyle.Render("none reserved")))
+	}
+	rows = append(rows, textRow("Anon THP", utils.FormatBytes(vm.AnonHugePages)))
+
+	detail := s.MemDetail
+	if detail.Zswapped > 0 {
+		rows = append(rows, textRow("Zswap", fmt.Sprintf("%s in %s pool (%.1fx)",
+			utils.FormatBytes(detail.Zswapped), utils.FormatBytes(detail.Zswap), float64(detail.Zswapped)/float64(max(detail.Zswap, 1)))))
+	} else {
+		rows = append(rows, textRow("Zswap", labelStyle.Render("unused")))
+	}
+	if detail.ZramDevices > 0 {
+		ratio := 0.0
+		if detail.ZramCompr > 0 {
+			ratio = float64(detail.ZramOrig) / float64(detail.ZramCompr)
+		}
+		rows = append(rows, textRow("Zram", fmt.Sprintf("%s → %s (%.1fx), %s used, %d dev",
+			utils.FormatBytes(detail.ZramOrig), utils.FormatBytes(detail.ZramCompr), ratio, utils.FormatBytes(detail.ZramUsed), detail.ZramDevices)))
+	} else {
+		rows = append(rows, textRow("Zram", labelStyle.Render("no devices")))
+	}
+
+	// Commit accounting: what the kernel promised against what it may promise
+	commitPct := 0.0
+	if vm.CommitLimit > 0 {
+		commitPct = float64(vm.CommittedAS) / float64(vm.CommitLimit) * 100
+	}
+	rows = append(rows, "",
+		textRow("Committed", fmt.Sprintf("%s / %s limit", utils.FormatBytes(vm.CommittedAS), utils.FormatBytes(vm.CommitLimit))),
+		labelStyle.Width(labelWidth).Render("")+" "+widgets.RenderProgressBar(commitPct, barWidth, su, w, a)+" "+
+			lipgloss.NewStyle().Foreground(widgets.GetColorForValue(commitPct, su, w, a)).Bold(true).Width(amountWidth).Align(lipgloss.Right).Render(fmt.Sprintf("%.0f%%", commitPct)),
+	)
+	breakdown := strings.Join(rows, "\n")
+
+	// Paging activity from /proc/vmstat
+	var paging string
+	if !detail.Available {
+		paging = labelStyle.Render("Paging counters are only available on Linux")
+	} else {
+		rate := func(v float64) string {
+			if v < 1 {
+				return "-"
+			}
+			return fmt.Sprintf("%.0f/s", v)
+		}
+		majorColor := t
+		if detail.MajorFaultRate >= 1 {
+			majorColor = w
+		}
+		swapColor := t
+		if detail.SwapInRate >= 1 || detail.SwapOutRate >= 1 {
+			swapColor = a
+		}
+		paging = strings.Join([]string{
+			textRow("Page faults", rate(detail.PageFaultRate)) + labelStyle.Render("   major ") +
+				lipgloss.NewStyle().Foreground(majorColor).Render(rate(detail.MajorFaultRate)),
+			textRow("Swap in", lipgloss.NewStyle().Foreground(swapColor).Render(formatIORate(detail.SwapInRate))) + labelStyle.Render("   out ") +
+				lipgloss.NewStyle().Foreground(swapColor).Render(formatIORate(detail.SwapOutRate)),
+		}, "\n")
+	}
+	pagingHeight := 3
+
+	// Stacked history: used at the bottom, then buffers and cached on top
+	colors := []compat.AdaptiveColor{p, w, su}
+	legend := lipgloss.NewStyle().Foreground(colors[0]).Render("█") + labelStyle.Render(" used  ") +
+		lipgloss.NewStyle().Foreground(colors[1]).Render("█") + labelStyle.Render(" buffers  ") +
+		lipgloss.NewStyle().Foreground(colors[2]).Render("█") + labelStyle.Render(" cached")
+	stackedChart := func(boxWidth, height int) string {
+		chartH := height - 1
+		if chartH < 1 {
+			chartH = 1
+		}
+		chartW := boxWidth - 4
+		if chartW < 5 {
+			chartW = 5
+		}
+		ch := widgets.RenderStackedChart([]data.Accessor{s.MemHistory, s.MemBuffersHistory, s.MemCachedHistory}, colors, chartW, chartH)
+		return lipgloss.JoinVertical(lipgloss.Left, ch, legend)
+	}
+	chartTitle := fmt.Sprintf("MEMORY COMPOSITION (Window: %ds)", s.HistoryLength)
+
+	if twoCols {
+		contentHeight := availHeight - 2
+		chartHeight := contentHeight - pagingHeight - 2
+		if chartHeight < 3 {
+			chartHeight = 3
+		}
+		right := lipgloss.JoinVertical(lipgloss.Left,
+			render(chartTitle, stackedChart(rightWidth, chartHeight), rightWidth, chartHeight),
+			render("PAGING", paging, rightWidth, pagingHeight),
+		)
+		left := render("BREAKDOWN", breakdown, leftWidth, contentHeight)
+		return lipgloss.JoinHorizontal(lipgloss.Top, left, right)
+	}
+
+	breakdownHeight := len(rows) + 1
+	chartHeight := availHeight - breakdownHeight - pagingHeight - 6
+	if chartHeight < 4 {
+		chartHeight = 4
+	}
+	return lipgloss.JoinVertical(lipgloss.Left,
+		render("BREAKDOWN", breakdown, s.Width, breakdownHeight),
+		render(chartTitle, stackedChart(s.Width, chartHeight), s.Width, chartHeight),
+		render("PAGING", paging, s.Width, pagingHeight),
+	)
+}
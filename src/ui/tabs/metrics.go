@@ -2,7 +2,9 @@ package tabs
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"charm.land/lipgloss/v2"
 	"charm.land/lipgloss/v2/compat"
@@ -39,6 +41,10 @@ func (s *SumAccessor) Get(i int) float64 {
 func RenderMetrics(app *data.AppState, container lipgloss.Style, su, w, a, s, t, mu, p, b compat.AdaptiveColor, availHeight int) string {
 	width := app.Width
 
+	if app.MetricsView == "interrupts" {
+		return renderInterrupts(app, container, su, w, a, t, mu, p, b, availHeight)
+	}
+
 	// 1. Calculate Layout Columns
 	// Charts
 	chartCols := 1
@@ -67,8 +73,23 @@ func RenderMetrics(app *data.AppState, container lipgloss.Style, su, w, a, s, t,
 		numCores = 1
 	}
 
-	numCoreRows := (numCores + coreCols - 1) / coreCols
+	coreGroups := groupCores(app)
+	numCoreRows := 0
+	for _, g := range coreGroups {
+		numCoreRows += (len(g.cores) + coreCols - 1) / coreCols
+		if g.title != "" {
+			numCoreRows++
+		}
+	}
+	if numCoreRows == 0 {
+		numCoreRows = 1
+	}
 	coreSectionHeight := numCoreRows + 2
+	hasBreakdown := len(app.CoreTimes) == len(app.CpuPerCore) && len(app.CoreTimes) > 0
+	if hasBreakdown {
+		// Legend with the all-core averages
+		coreSectionHeight++
+	}
 
 	// 3. Calculate Available Chart Height
 	availChartSpace := availHeight - coreSectionHeight
@@ -79,6 +100,10 @@ func RenderMetrics(app *data.AppState, container lipgloss.Style, su, w, a, s, t,
 
 	// 4. Distribute Space to Charts
 	numChartRows := (4 + chartCols - 1) / chartCols
+	if app.Pressure.Available {
+		// PSI charts share one extra row
+		numChartRows++
+	}
 	chartBlockHeight := availChartSpace / numChartRows
 	if chartBlockHeight < 5 {
 		chartBlockHeight = 5
@@ -143,8 +168,32 @@ func RenderMetrics(app *data.AppState, container lipgloss.Style, su, w, a, s, t,
 			stats := fmt.Sprintf("Cur: %.1f%% Avg: %.1f%% Peak: %.1f%%", app.Memory, app.MemHistory.Avg(), app.MemHistory.Max())
 			innerBlock = lipgloss.JoinVertical(lipgloss.Left, ch, textStyle.Render(stats))
 		case 2: // Net
-			ch := renderChart(app.NetHistory, chartW, sparklineH, su, w)
-			stats := fmt.Sprintf("Peak: %.1f%% Recv: %.2f MB/s Sent: %.2f MB/s", app.NetHistory.Max(), app.NetRecvRate, app.NetSentRate)
+			// Receive above send on a shared scale, or their sum when
+			// there is no room for two charts
+			capacity := linkCapacity(app)
+			scale := netScale(app, capacity, app.NetRxHistory, app.NetTxHistory)
+			bits := netBits(app)
+			chartFor := func(c1 compat.AdaptiveColor) func(data.Accessor, int, int) string {
+				return func(a data.Accessor, w2, h2 int) string {
+					return renderChart(a, w2, h2, c1, w)
+				}
+			}
+			var ch string
+			if sparklineH >= 4 {
+				ch = lipgloss.JoinVertical(lipgloss.Left,
+					renderRateChart(chartFor(su), app.NetRxHistory, scale, bits, contentW, sparklineH/2),
+					renderRateChart(chartFor(s), app.NetTxHistory, scale, bits, contentW, sparklineH-sparklineH/2),
+				)
+			} else {
+				total := &SumAccessor{A: app.NetRxHistory, B: app.NetTxHistory}
+				scale = netScale(app, capacity, total)
+				ch = renderRateChart(chartFor(su), total, scale, bits, contentW, sparklineH)
+			}
+			source := "peak"
+			if app.Config.NetScale == "link" && capacity > 0 {
+				source = "link"
+			}
+			stats := fmt.Sprintf("↓ %s ↑ %s Scale: %s/s (%s)", formatNetRate(app, app.NetRecvRate), formatNetRate(app, app.NetSentRate), scaleLabel(scale, bits), source)
 			innerBlock = lipgloss.JoinVertical(lipgloss.Left, ch, textStyle.Render(stats))
 		case 3: // Disk I/O
 			totalIO := &SumAccessor{A: app.DiskHORead, B: app.DiskHOWrite}
@@ -169,6 +218,44 @@ func RenderMetrics(app *data.AppState, container lipgloss.Style, su, w, a, s, t,
 		}
 		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, renderedCharts[i:end]...))
 	}
+
+	// PSI row: CPU, memory and I/O pressure side by side
+	if app.Pressure.Available {
+		psiWidths := utils.CalculateColumnWidths(width, 3)
+		psiCharts := []struct {
+			title    string
+			history  *data.RingBuffer
+			pressure data.Pressure
+		}{
+			{"CPU PRESSURE", app.CpuPSIHistory, app.Pressure.CPU},
+			{"MEMORY PRESSURE", app.MemPSIHistory, app.Pressure.Memory},
+			{"I/O PRESSURE", app.IOPSIHistory, app.Pressure.IO},
+		}
+		var psiBoxes []string
+		for i, pc := range psiCharts {
+			boxW := psiWidths[i]
+			chartW := boxW - 10
+			if chartW < 5 {
+				chartW = 5
+			}
+			sparklineH := chartBlockHeight - 4
+			if sparklineH < 1 {
+				sparklineH = 1
+			}
+
+			ch := renderChart(pc.history, chartW, sparklineH, w, a)
+			some := fmt.Sprintf("some %.1f/%.1f/%.1f%%", pc.pressure.Some.Avg10, pc.pressure.Some.Avg60, pc.pressure.Some.Avg300)
+			full := fmt.Sprintf("full %.1f/%.1f/%.1f%% stalled %s", pc.pressure.Full.Avg10, pc.pressure.Full.Avg60, pc.pressure.Full.Avg300,
+				utils.FormatDuration(time.Duration(pc.pressure.Full.Total)*time.Microsecond))
+			innerBlock := lipgloss.JoinVertical(lipgloss.Left, ch, textStyle.Render(some), textStyle.Render(full))
+
+			c := container.Width(boxW).Height(chartBlockHeight - 1).BorderTop(false)
+			body := c.Render(innerBlock)
+			topBorder := widgets.RenderTopBorderWithBg(pc.title, boxW, widgets.GetBorder(app.BorderStyle, app.BorderType), b, p)
+			psiBoxes = append(psiBoxes, lipgloss.JoinVertical(lipgloss.Left, topBorder, body))
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, psiBoxes...))
+	}
 	topSection := lipgloss.JoinVertical(lipgloss.Left, rows...)
 
 	// 6. Render Cores
@@ -176,8 +263,23 @@ func RenderMetrics(app *data.AppState, container lipgloss.Style, su, w, a, s, t,
 	var coreBlocks []string
 	textStyle = lipgloss.NewStyle().Foreground(t)
 
+	// Grid column of each core within its group
+	corePos := make([]int, len(app.CpuPerCore))
+	for _, g := range coreGroups {
+		for j, core := range g.cores {
+			corePos[core] = j % coreCols
+		}
+	}
+
+	// user, nice, system, iowait, irq, softirq, steal, guest
+	timeLabels := []string{"usr", "nice", "sys", "iowait", "irq", "sirq", "steal", "guest"}
+	timeColors := []compat.AdaptiveColor{su, s, p, w, t, mu, a, s}
+	timeValues := func(ct data.CoreTimes) []float64 {
+		return []float64{ct.User, ct.Nice, ct.System, ct.Iowait, ct.Irq, ct.Softirq, ct.Steal, ct.Guest}
+	}
+
 	for i, usage := range app.CpuPerCore {
-		cW := coreColWidths[i%coreCols] - 4
+		cW := coreColWidths[corePos[i]] - 4
 		if cW < 10 {
 			cW = 10
 		}
@@ -186,34 +288,107 @@ func RenderMetrics(app *data.AppState, container lipgloss.Style, su, w, a, s, t,
 			barW = 5
 		}
 
-		bar := widgets.RenderProgressBar(usage, barW, su, w, a)
+		// Clock as "cur/max G" when cpufreq reports it and there is room
+		freq := ""
+		if i < len(app.CoreFreqs) && app.CoreFreqs[i].Current > 0 && barW >= 18 {
+			freq = fmt.Sprintf(" %.1f", app.CoreFreqs[i].Current/1000)
+			if app.CoreFreqs[i].Max > 0 {
+				freq += fmt.Sprintf("/%.1f", app.CoreFreqs[i].Max/1000)
+			}
+			freq += "G"
+			barW -= len(freq)
+		}
+
+		var bar string
+		if hasBreakdown {
+			bar = widgets.RenderStackedBar(timeValues(app.CoreTimes[i]), timeColors, barW)
+		} else {
+			bar = widgets.RenderProgressBar(usage, barW, su, w, a)
+		}
 		line := lipgloss.JoinHorizontal(lipgloss.Left,
 			textStyle.Width(16).Render(fmt.Sprintf("Core %-2d: %5.1f%% ", i, usage)),
 			bar,
+			lipgloss.NewStyle().Foreground(mu).Render(freq),
 		)
 		coreBlocks = append(coreBlocks, line)
 	}
 
 	var coreRows []string
-	for i := 0; i < len(coreBlocks); i += coreCols {
-		end := i + coreCols
-		if end > len(coreBlocks) {
-			end = len(coreBlocks)
+	for _, g := range coreGroups {
+		if g.title != "" {
+			coreRows = append(coreRows, lipgloss.NewStyle().Foreground(p).Bold(true).Render(g.title))
 		}
-		rowItems := coreBlocks[i:end]
-
-		var rowStr string
-		for j, item := range rowItems {
-			w := coreColWidths[(i+j)%coreCols]
-			rowStr = lipgloss.JoinHorizontal(lipgloss.Top, rowStr, lipgloss.NewStyle().Width(w).Render(item))
+		for i := 0; i < len(g.cores); i += coreCols {
+			end := i + coreCols
+			if end > len(g.cores) {
+				end = len(g.cores)
+			}
+			rowItems := g.cores[i:end]
+
+			var rowStr string
+			for j, core := range rowItems {
+				w := coreColWidths[(i+j)%coreCols]
+				rowStr = lipgloss.JoinHorizontal(lipgloss.Top, rowStr, lipgloss.NewStyle().Width(w).Render(coreBlocks[core]))
+			}
+			coreRows = append(coreRows, rowStr)
 		}
-		coreRows = append(coreRows, rowStr)
+	}
+	if hasBreakdown {
+		// Average of every state across cores, colored like the bars
+		var avg data.CoreTimes
+		for _, ct := range app.CoreTimes {
+			avg.User += ct.User
+			avg.Nice += ct.Nice
+			avg.System += ct.System
+			avg.Iowait += ct.Iowait
+			avg.Irq += ct.Irq
+			avg.Softirq += ct.Softirq
+			avg.Steal += ct.Steal
+			avg.Guest += ct.Guest
+		}
+		n := float64(len(app.CoreTimes))
+		values := timeValues(avg)
+		label := func(j int) string {
+			return fmt.Sprintf(" %s %.1f%%", timeLabels[j], values[j]/n)
+		}
+		shown := make([]bool, len(values))
+		legendW := -2
+		for j := range values {
+			shown[j] = true
+			legendW += len(label(j)) + 3
+		}
+		// On narrow terminals drop the smallest states first
+		for legendW > width-4 {
+			smallest := -1
+			for j := range values {
+				if shown[j] && (smallest < 0 || values[j] <= values[smallest]) {
+					smallest = j
+				}
+			}
+			shown[smallest] = false
+			legendW -= len(label(smallest)) + 3
+		}
+		var legend []string
+		for j := range values {
+			if shown[j] {
+				legend = append(legend, lipgloss.NewStyle().Foreground(timeColors[j]).Render("█")+
+					lipgloss.NewStyle().Foreground(mu).Render(label(j)))
+			}
+		}
+		coreRows = append(coreRows, strings.Join(legend, "  "))
 	}
 	coresC := strings.Join(coreRows, "\n")
 
 	// Assemble Bottom Section (Cores)
 	coresBoxWidth := width
-	topBorder := widgets.RenderTopBorderWithBg("CPU PER CORE", coresBoxWidth, widgets.GetBorder(app.BorderStyle, app.BorderType), b, p)
+	coresTitle := "CPU PER CORE"
+	switch app.CoreGrouping {
+	case "socket":
+		coresTitle += " (BY SOCKET)"
+	case "node":
+		coresTitle += " (BY NUMA NODE)"
+	}
+	topBorder := widgets.RenderTopBorderWithBg(coresTitle, coresBoxWidth, widgets.GetBorder(app.BorderStyle, app.BorderType), b, p)
 
 	coreBodyHeight := coreSectionHeight - 1
 	c := container.Width(coresBoxWidth).Height(coreBodyHeight).BorderTop(false)
@@ -223,3 +398,49 @@ func RenderMetrics(app *data.AppState, container lipgloss.Style, su, w, a, s, t,
 
 	return lipgloss.JoinVertical(lipgloss.Left, topSection, bottomSection)
 }
+
+// coreGroup is a titled set of core indices in the per-core grid
+type coreGroup struct {
+	title string
+	cores []int
+}
+
+// groupCores splits the per-core grid by socket or NUMA node as selected.
+// Without a grouping or a known topology all cores form one untitled group.
+func groupCores(app *data.AppState) []coreGroup {
+	all := coreGroup{}
+	for i := range app.CpuPerCore {
+		all.cores = append(all.cores, i)
+	}
+	if app.CoreGrouping == "" || !app.Topology.Available {
+		return []coreGroup{all}
+	}
+
+	placement := make(map[int]data.CPUPlacement, len(app.Topology.CPUs))
+	for _, pl := range app.Topology.CPUs {
+		placement[pl.CPU] = pl
+	}
+	byKey := make(map[int][]int)
+	var keys []int
+	for _, i := range all.cores {
+		key := placement[i].Socket
+		if app.CoreGrouping == "node" {
+			key = placement[i].Node
+		}
+		if _, ok := byKey[key]; !ok {
+			keys = append(keys, key)
+		}
+		byKey[key] = append(byKey[key], i)
+	}
+	sort.Ints(keys)
+
+	groups := make([]coreGroup, 0, len(keys))
+	for _, key := range keys {
+		title := fmt.Sprintf("Socket %d", key)
+		if app.CoreGrouping == "node" {
+			title = fmt.Sprintf("Node %d", key)
+		}
+		groups = append(groups, coreGroup{title: fmt.Sprintf("%s (%d CPUs)", title, len(byKey[key])), cores: byKey[key]})
+	}
+	return groups
+}
@@ -2,6 +2,7 @@ package tabs
 
 import (
 	"fmt"
+	"strings"
 
 	"charm.land/lipgloss/v2"
 	"charm.land/lipgloss/v2/compat"
@@ -12,7 +13,7 @@ import (
 )
 
 // RenderNetwork renders the network interfaces tab
-func RenderNetwork(s *data.AppState, container, titleStyle, labelStyle, valueStyle lipgloss.Style, t, mu, p, b, bg compat.AdaptiveColor, availHeight int) string {
+func RenderNetwork(s *data.AppState, container, titleStyle, labelStyle, valueStyle lipgloss.Style, su, w, a, t, mu, p, b, bg compat.AdaptiveColor, availHeight int) string {
 	if len(s.NetworkInterfaces) == 0 {
 		return "Loading network interfaces..."
 	}
@@ -32,46 +33,127 @@ func RenderNetwork(s *data.AppState, container, titleStyle, labelStyle, valueSty
 	fwLine := func(str string, w int) string {
 		return utils.FullWidthBg(str, w)
 	}
+	trunc := func(str string, width int) string {
+		return utils.Truncate(str, width-1)
+	}
+	// First address, with a count of the others
+	addrList := func(addrs []string, width int) string {
+		if len(addrs) == 0 {
+			return "-"
+		}
+		more := ""
+		if len(addrs) > 1 {
+			more = fmt.Sprintf(" (+%d)", len(addrs)-1)
+		}
+		return trunc(addrs[0], width-lipgloss.Width(more)) + more
+	}
 
 	var netBlocks []string
 	for i, nic := range s.NetworkInterfaces {
 		cW := colWidths[i%cols] - 4
+		info, known := s.InterfaceDetails[nic.Name]
+
+		// State, link speed and MTU
+		var stateLine string
+		borderColor := b
+		if known {
+			state := info.LinkState()
+			stateColor := w
+			switch state {
+			case "up":
+				stateColor = su
+			case "down", "no carrier", "lowerlayerdown", "notpresent":
+				stateColor = a
+				borderColor = mu
+			}
+			link := []string{fmt.Sprintf("MTU %d", info.MTU)}
+			if info.Speed > 0 {
+				speed := fmt.Sprintf("%d Mb/s", info.Speed)
+				if info.Speed >= 1000 {
+					speed = fmt.Sprintf("%g Gb/s", float64(info.Speed)/1000)
+				}
+				if info.Duplex != "" {
+					speed += " " + info.Duplex
+				}
+				link = append([]string{speed}, link...)
+			}
+			stateLine = lipgloss.NewStyle().Foreground(stateColor).Bold(true).Render("● "+state) +
+				labelStyle.Render("  "+strings.Join(link, " • "))
+		} else {
+			stateLine = labelStyle.Render("● state unknown")
+		}
 
-		// Check for inactivity
-		if nic.BytesRecv == 0 && nic.BytesSent == 0 {
-			inactiveStyle := lipgloss.NewStyle().Foreground(mu).Align(lipgloss.Center).Width(cW)
-			msg := inactiveStyle.Render("(Inactive / Down)")
-
-			c := container.Width(colWidths[i%cols]).Height(8).BorderTop(false)
-			body := c.Render(lipgloss.NewStyle().MarginTop(3).Render(msg))
-
-			topBorder := widgets.RenderTopBorderWithBg(nic.Name, colWidths[i%cols], border, mu, p)
-			netBlocks = append(netBlocks, lipgloss.JoinVertical(lipgloss.Left, topBorder, body))
-			continue
+		// Hardware: MAC and driver, or what kind of virtual device it is
+		hw := info.Driver
+		switch {
+		case info.Loopback:
+			hw = "loopback"
+		case info.Virtual && info.Kind != "":
+			hw = "virtual " + info.Kind
+		case info.Virtual:
+			hw = "virtual"
+		case hw == "":
+			hw = "-"
+		}
+		hwLine := labelStyle.Render("HW:   ") + valueStyle.Render(hw)
+		if info.MAC != "" {
+			hwLine = labelStyle.Render("HW:   ") + valueStyle.Render(info.MAC) + labelStyle.Render(" • ") + valueStyle.Render(hw)
 		}
 
-		var rxRate, txRate float64
-		if last, ok := s.LastNetworkInterfaces[nic.Name]; ok {
-			if nic.BytesRecv >= last.BytesRecv {
-				rxRate = float64(nic.BytesRecv-last.BytesRecv) / 1024 / 1024
+		// Rx and Tx share a scale: the link speed or the busier direction's peak
+		rate := s.InterfaceRates[nic.Name]
+		rxHist, txHist := s.InterfaceRxHistory[nic.Name], s.InterfaceTxHistory[nic.Name]
+		capacity := 0.0
+		if info.Speed > 0 {
+			capacity = float64(info.Speed) * 1e6 / 8
+		}
+		var scale float64
+		if rxHist != nil && txHist != nil {
+			scale = netScale(s, capacity, rxHist, txHist)
+		}
+		chart := func(hist *data.RingBuffer, color compat.AdaptiveColor) string {
+			if hist == nil {
+				return ""
+			}
+			sparkline := func(a data.Accessor, width, height int) string {
+				return widgets.RenderSparkline(a, width, height, color, color)
 			}
-			if nic.BytesSent >= last.BytesSent {
-				txRate = float64(nic.BytesSent-last.BytesSent) / 1024 / 1024
+			return labelStyle.Render(renderRateChart(sparkline, hist, scale, netBits(s), cW, 1))
+		}
+
+		// Wireless link and quality history; wired cards get blank lines
+		// to keep the rows aligned
+		var wifiLines []string
+		if len(s.Wireless) > 0 {
+			wifiLines = []string{fwLine("", cW), fwLine("", cW)}
+			if link, ok := s.Wireless[nic.Name]; ok {
+				wifiLines = []string{
+					fwLine(lipgloss.NewStyle().MaxWidth(cW).Render(wirelessLine(link, labelStyle, valueStyle, su, w, a)), cW),
+					fwLine(labelStyle.Render("Sig:  ")+signalChart(s.SignalHistory[nic.Name], cW-6, su, w), cW),
+				}
 			}
 		}
 
-		stats := lipgloss.JoinVertical(lipgloss.Left,
-			fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render("Rx Total: ")+valueStyle.Render(utils.FormatBytes(nic.BytesRecv))), cW),
-			fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render("Tx Total: ")+valueStyle.Render(utils.FormatBytes(nic.BytesSent))), cW),
-			fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render("Rx Rate:  ")+valueStyle.Render(fmt.Sprintf("%.2f MB/s", rxRate))), cW),
-			fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render("Tx Rate:  ")+valueStyle.Render(fmt.Sprintf("%.2f MB/s", txRate))), cW),
-			fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render("Errors:   ")+valueStyle.Render(fmt.Sprintf("In:%d Out:%d", nic.Errin, nic.Errout))), cW),
-			fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render("Dropped:  ")+valueStyle.Render(fmt.Sprintf("In:%d Out:%d", nic.Dropin, nic.Dropout))), cW),
+		lines := []string{
+			fwLine(lipgloss.NewStyle().MaxWidth(cW).Render(stateLine), cW),
+			fwLine(lipgloss.NewStyle().MaxWidth(cW).Render(hwLine), cW),
+		}
+		lines = append(lines, wifiLines...)
+		lines = append(lines,
+			fwLine(labelStyle.Render("IPv4: ")+valueStyle.Render(addrList(info.IPv4, cW-6)), cW),
+			fwLine(labelStyle.Render("IPv6: ")+valueStyle.Render(addrList(info.IPv6, cW-6)), cW),
+			fwLine(labelStyle.Render("Rx: ")+lipgloss.NewStyle().Foreground(su).Bold(true).Render(formatNetRate(s, rate.Rx))+labelStyle.Render("  total "+utils.FormatBytes(nic.BytesRecv)), cW),
+			fwLine(chart(s.InterfaceRxHistory[nic.Name], su), cW),
+			fwLine(labelStyle.Render("Tx: ")+lipgloss.NewStyle().Foreground(p).Bold(true).Render(formatNetRate(s, rate.Tx))+labelStyle.Render("  total "+utils.FormatBytes(nic.BytesSent)), cW),
+			fwLine(chart(s.InterfaceTxHistory[nic.Name], p), cW),
+			fwLine(labelStyle.Render("Err: ")+valueStyle.Render(fmt.Sprintf("%d/%d", nic.Errin, nic.Errout))+
+				labelStyle.Render("  Drop: ")+valueStyle.Render(fmt.Sprintf("%d/%d", nic.Dropin, nic.Dropout)), cW),
 		)
+		stats := lipgloss.JoinVertical(lipgloss.Left, lines...)
 
-		c := container.Width(colWidths[i%cols]).Height(8).BorderTop(false)
-		body := c.Render(lipgloss.NewStyle().MarginTop(1).Render(stats))
-		topBorder := widgets.RenderTopBorderWithBg(nic.Name, colWidths[i%cols], border, b, p)
+		c := container.Width(colWidths[i%cols]).Height(len(lines)).BorderTop(false)
+		body := c.Render(stats)
+		topBorder := widgets.RenderTopBorderWithBg(nic.Name, colWidths[i%cols], border, borderColor, p)
 
 		netBlocks = append(netBlocks, lipgloss.JoinVertical(lipgloss.Left, topBorder, body))
 	}
@@ -87,3 +169,42 @@ func RenderNetwork(s *data.AppState, container, titleStyle, labelStyle, valueSty
 
 	return lipgloss.JoinVertical(lipgloss.Left, rows...)
 }
+
+// wirelessLine summarizes a wireless link: SSID, signal colored by strength,
+// quality, tx/rx bitrates and noise, least important last
+func wirelessLine(link data.WirelessInfo, labelStyle, valueStyle lipgloss.Style, su, w, a compat.AdaptiveColor) string {
+	line := labelStyle.Render("WiFi: ")
+	if !link.Connected {
+		return line + labelStyle.Render("not associated")
+	}
+	ssid := link.SSID
+	if ssid == "" {
+		ssid = "-"
+	}
+	signalColor := su
+	switch {
+	case link.Signal < -75:
+		signalColor = a
+	case link.Signal < -67:
+		signalColor = w
+	}
+	line += valueStyle.Render(ssid) + labelStyle.Render(" • ") +
+		lipgloss.NewStyle().Foreground(signalColor).Bold(true).Render(fmt.Sprintf("%.0f dBm", link.Signal)) +
+		labelStyle.Render(fmt.Sprintf(" %.0f%%", link.Quality))
+	if link.TxBitrate > 0 || link.RxBitrate > 0 {
+		line += labelStyle.Render(" • ") + valueStyle.Render(fmt.Sprintf("%.0f/%.0f Mb/s", link.TxBitrate, link.RxBitrate))
+	}
+	if link.Noise != 0 {
+		line += labelStyle.Render(fmt.Sprintf(" • noise %.0f", link.Noise))
+	}
+	return line
+}
+
+// signalChart draws the link quality history on an absolute 0-100% scale,
+// good links in the success color
+func signalChart(hist *data.RingBuffer, width int, su, w compat.AdaptiveColor) string {
+	if hist == nil || hist.Len() == 0 || width < 1 {
+		return ""
+	}
+	return widgets.RenderSparkline(&ScaledAccessor{A: hist, Scale: 100}, width, 1, w, su)
+}
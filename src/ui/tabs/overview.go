@@ -89,6 +89,12 @@ func RenderOverview(s *data.AppState, container, titleStyle, labelStyle, valueSt
 		diskUsed = s.DiskPartitions[0].Used
 		diskTotal = s.DiskPartitions[0].Total
 	}
+	// The root filesystem, wherever the Disks tab sorts it
+	for _, d := range s.DiskPartitions {
+		if d.Mountpoint == "/" {
+			diskUsed, diskTotal = d.Used, d.Total
+		}
+	}
 	diskBlock := lipgloss.JoinVertical(lipgloss.Left,
 		fwLine(diskVal, idx),
 		fwLine(diskBar, idx),
@@ -102,13 +108,10 @@ func RenderOverview(s *data.AppState, container, titleStyle, labelStyle, valueSt
 	netBar := widgets.RenderProgressBar(nP, cw, su, w, a)
 	netVal := valueStyle.Foreground(widgets.GetColorForValue(nP, su, w, a)).Render(fmt.Sprintf("%.1f%%", nP))
 
-	recvMb := s.NetRecvRate * 8
-	sentMb := s.NetSentRate * 8
-
 	netBlock := lipgloss.JoinVertical(lipgloss.Left,
 		fwLine(netVal, idx),
 		fwLine(netBar, idx),
-		fwLine(labelStyle.Render("↓")+sp(" ")+labelStyle.Render(fmt.Sprintf("%.2f Mb/s", recvMb))+sp(" ")+labelStyle.Render("↑")+sp(" ")+labelStyle.Render(fmt.Sprintf("%.2f Mb/s", sentMb)), idx),
+		fwLine(labelStyle.Render("↓")+sp(" ")+labelStyle.Render(formatNetRate(s, s.NetRecvRate))+sp(" ")+labelStyle.Render("↑")+sp(" ")+labelStyle.Render(formatNetRate(s, s.NetSentRate)), idx),
 	)
 
 	// Quick Stats (Index 4)
@@ -0,0 +1,169 @@
+package tabs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/ui/widgets"
+)
+
+// RenderProbes renders the Probes view of the Network tab: each configured
+// endpoint with its latest latencies, success rate and latency history
+func RenderProbes(s *data.AppState, container lipgloss.Style, su, w, a, t, mu, p, b compat.AdaptiveColor, availHeight int) string {
+	boxWidth := s.Width
+	contentWidth := boxWidth - 4
+	border := widgets.GetBorder(s.BorderStyle, s.BorderType)
+
+	contentHeight := availHeight - 2
+	if contentHeight < 0 {
+		contentHeight = 0
+	}
+
+	render := func(title, content string) string {
+		c := container.Width(boxWidth).Height(contentHeight).BorderTop(false)
+		body := c.Render(content)
+		topBorder := widgets.RenderTopBorderWithBg(title, boxWidth, border, b, p)
+		return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+	}
+
+	mutedStyle := lipgloss.NewStyle().Foreground(mu)
+	textStyle := lipgloss.NewStyle().Foreground(t)
+
+	if len(s.Config.Probes) == 0 {
+		msg := lipgloss.JoinVertical(lipgloss.Left,
+			textStyle.Bold(true).Render("No probes configured"),
+			"",
+			mutedStyle.Render(`Add endpoints to "probes" in the config file, e.g.`),
+			mutedStyle.Render(`{"name": "db", "type": "tcp", "target": "10.0.0.5:5432"}`),
+			mutedStyle.Render(`{"name": "api", "type": "http", "target": "https://api.local/health", "max_latency": 250}`),
+		)
+		return render("PROBES", msg)
+	}
+
+	nameWidth := 14
+	typeWidth := 5
+	statusWidth := 16
+	latWidth := 8
+	okWidth := 6
+	fixed := nameWidth + typeWidth + statusWidth + 4*latWidth + okWidth + 8
+	histWidth := 0
+	targetWidth := contentWidth - fixed
+	if targetWidth > 40 {
+		// Leftover width goes to the history, keeping the target readable
+		histWidth = targetWidth - 30
+		targetWidth = 30
+	}
+	if targetWidth < 10 {
+		targetWidth = 10
+	}
+
+	hdrStyle := lipgloss.NewStyle().Bold(true).Underline(true)
+	headerRow := hdrStyle.Width(nameWidth).Render("NAME") + " " +
+		hdrStyle.Width(typeWidth).Render("TYPE") + " " +
+		hdrStyle.Width(targetWidth).Render("TARGET") + " " +
+		hdrStyle.Width(statusWidth).Render("STATUS") + " " +
+		hdrStyle.Width(latWidth).Align(lipgloss.Right).Render("CONNECT") + " " +
+		hdrStyle.Width(latWidth).Align(lipgloss.Right).Render("TLS") + " " +
+		hdrStyle.Width(latWidth).Align(lipgloss.Right).Render("TOTAL") + " " +
+		hdrStyle.Width(latWidth).Align(lipgloss.Right).Render("AVG") + " " +
+		hdrStyle.Width(okWidth).Align(lipgloss.Right).Render("OK%")
+	if histWidth > 0 {
+		headerRow += " " + hdrStyle.Width(histWidth).Render("LATENCY")
+	}
+
+	trunc := func(str string, width int) string {
+		if len(str) > width-1 {
+			return str[:width-2] + "…"
+		}
+		return str
+	}
+
+	var rows []string
+	down := 0
+	for _, cfg := range s.Config.Probes {
+		state := s.Probes[cfg.Name]
+		status, statusColor := "pending", mu
+		var last data.ProbeResult
+		if state != nil && !state.Last.Time.IsZero() {
+			last = state.Last
+			switch {
+			case !last.OK:
+				status, statusColor = last.Err, a
+				down++
+			case cfg.MaxLatency > 0 && float64(last.Total)/float64(time.Millisecond) > cfg.MaxLatency:
+				status, statusColor = "slow", w
+			default:
+				status, statusColor = "ok", su
+			}
+			if last.Status > 0 && last.OK {
+				status += fmt.Sprintf(" %d", last.Status)
+			}
+		}
+
+		okRate, okColor := "-", t
+		avg := 0.0
+		if state != nil && state.SuccessHistory.Len() > 0 {
+			rate := state.SuccessRate()
+			okRate = fmt.Sprintf("%.0f", rate)
+			switch {
+			case cfg.MinSuccess > 0 && rate < cfg.MinSuccess:
+				okColor = a
+			case rate < 100:
+				okColor = w
+			}
+			avg = state.TotalHistory.Avg()
+		}
+
+		row := textStyle.Bold(true).Width(nameWidth).Render(trunc(cfg.Name, nameWidth)) + " " +
+			mutedStyle.Width(typeWidth).Render(cfg.Type) + " " +
+			textStyle.Width(targetWidth).Render(trunc(cfg.Target, targetWidth)) + " " +
+			lipgloss.NewStyle().Foreground(statusColor).Width(statusWidth).Render(trunc(status, statusWidth)) + " " +
+			textStyle.Width(latWidth).Align(lipgloss.Right).Render(formatLatency(last.Connect)) + " " +
+			textStyle.Width(latWidth).Align(lipgloss.Right).Render(formatLatency(last.Handshake)) + " " +
+			textStyle.Bold(true).Width(latWidth).Align(lipgloss.Right).Render(formatLatency(last.Total)) + " " +
+			mutedStyle.Width(latWidth).Align(lipgloss.Right).Render(formatLatency(time.Duration(avg*float64(time.Millisecond)))) + " " +
+			lipgloss.NewStyle().Foreground(okColor).Width(okWidth).Align(lipgloss.Right).Render(okRate)
+		if histWidth > 0 && state != nil && state.TotalHistory.Len() > 0 {
+			row += " " + widgets.RenderSparkline(state.TotalHistory, histWidth, 1, p, p)
+		}
+		rows = append(rows, row)
+	}
+
+	visibleRows := contentHeight - 1
+	if visibleRows < 1 {
+		visibleRows = 1
+	}
+	if len(rows) > visibleRows {
+		rows = rows[:visibleRows]
+	}
+
+	title := fmt.Sprintf("PROBES (%d endpoints)", len(s.Config.Probes))
+	if down > 0 {
+		title = fmt.Sprintf("PROBES (%d endpoints, %d down)", len(s.Config.Probes), down)
+	}
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.NewStyle().Width(contentWidth).Render(headerRow),
+		strings.Join(rows, "\n"),
+	)
+	return render(title, content)
+}
+
+// formatLatency formats a latency compactly, "-" when not measured
+func formatLatency(d time.Duration) string {
+	ms := float64(d) / float64(time.Millisecond)
+	switch {
+	case d <= 0:
+		return "-"
+	case ms < 10:
+		return fmt.Sprintf("%.2fms", ms)
+	case ms < 1000:
+		return fmt.Sprintf("%.0fms", ms)
+	default:
+		return fmt.Sprintf("%.2fs", ms/1000)
+	}
+}
@@ -13,6 +13,47 @@ import (
 	"github.com/N1xev/bubbleMonitor/src/utils"
 )
 
+// processColumns holds the widths of the process list columns
+type processColumns struct {
+	pid, status, cpu, mem, io int
+	container                 int // 0 when nothing runs in a container
+	name                      int
+	net                       int // Each of NET RX and TX, 0 when not shown
+}
+
+// processColumnWidths lays out the process list columns for the terminal
+// width, with the NET columns if withNet and there is room for them
+func processColumnWidths(s *data.AppState, withNet bool) processColumns {
+	cols := processColumns{pid: 10, status: 12, cpu: 8, mem: 8, io: 11}
+
+	// Only show the CONTAINER column when something runs in a container
+	for _, proc := range s.Processes {
+		if proc.Container != "" {
+			cols.container = 14
+			break
+		}
+	}
+
+	cols.name = s.Width - 4 - cols.pid - cols.status - cols.cpu - cols.mem - cols.io - 5
+	if cols.container > 0 {
+		cols.name -= cols.container + 1
+	}
+	if withNet && cols.name-22 >= 20 {
+		cols.net = 10
+		cols.name -= 2 * (cols.net + 1)
+	}
+	if cols.name < 20 {
+		cols.name = 20
+	}
+	return cols
+}
+
+// NetColumnsFit reports whether the Processes tab has room for its NET
+// columns, the only reason to sample socket traffic while it is shown
+func NetColumnsFit(s *data.AppState) bool {
+	return processColumnWidths(s, true).net > 0
+}
+
 // RenderProcesses renders the processes tab
 func RenderProcesses(s *data.AppState, visibleProcs []data.ProcessInfo, treeIndents map[int32]int, container lipgloss.Style, su, w, a, t, mu, p, b compat.AdaptiveColor, availHeight int) string {
 	boxWidth := s.Width
@@ -28,15 +69,10 @@ func RenderProcesses(s *data.AppState, visibleProcs []data.ProcessInfo, treeInde
 
 	contentWidth := boxWidth - 4
 
-	pidWidth := 8
-	statusWidth := 12
-	cpuWidth := 8
-	memWidth := 8
-
-	nameWidth := contentWidth - pidWidth - statusWidth - cpuWidth - memWidth - 4
-	if nameWidth < 20 {
-		nameWidth = 20
-	}
+	// Per-process TCP throughput, when sock_diag works and there is room
+	cols := processColumnWidths(s, s.SocketTraffic.Available)
+	pidWidth, statusWidth, cpuWidth, memWidth, ioWidth := cols.pid, cols.status, cols.cpu, cols.mem, cols.io
+	containerWidth, nameWidth, netWidth := cols.container, cols.name, cols.net
 
 	sp := func(str string) string { return str }
 
@@ -51,11 +87,19 @@ func RenderProcesses(s *data.AppState, visibleProcs []data.ProcessInfo, treeInde
 	}
 
 	hdrStyle := lipgloss.NewStyle().Bold(true).Underline(true)
-	headerRow := hdrStyle.Width(pidWidth).Render("PID"+pSI) + sp(" ") +
-		hdrStyle.Width(nameWidth).Render("NAME") + sp(" ") +
-		hdrStyle.Width(statusWidth).Render("STATUS") + sp(" ") +
+	headerRow := hdrStyle.Width(pidWidth).Render("  PID"+pSI) + sp(" ") +
+		hdrStyle.Width(nameWidth).Render("NAME") + sp(" ")
+	if containerWidth > 0 {
+		headerRow += hdrStyle.Width(containerWidth).Render("CONTAINER") + sp(" ")
+	}
+	headerRow += hdrStyle.Width(statusWidth).Render("STATUS") + sp(" ") +
 		hdrStyle.Width(cpuWidth).Align(lipgloss.Right).Render("CPU"+sI) + sp(" ") +
-		hdrStyle.Width(memWidth).Align(lipgloss.Right).Render("MEM"+mSI)
+		hdrStyle.Width(memWidth).Align(lipgloss.Right).Render("MEM"+mSI) + sp(" ") +
+		hdrStyle.Width(ioWidth).Align(lipgloss.Right).Render("I/O")
+	if netWidth > 0 {
+		headerRow += sp(" ") + hdrStyle.Width(netWidth).Align(lipgloss.Right).Render("NET RX") + sp(" ") +
+			hdrStyle.Width(netWidth).Align(lipgloss.Right).Render("NET TX")
+	}
 
 	filtered := visibleProcs
 
@@ -132,12 +176,18 @@ func RenderProcesses(s *data.AppState, visibleProcs []data.ProcessInfo, treeInde
 
 		name := proc.Name
 
-		if s.TreeView {
+		if proc.Group != nil {
+			indicator := "▶ "
+			if s.ExpandedGroups[proc.Group.Key] {
+				indicator = "▼ "
+			}
+			name = indicator + name
+		} else if s.TreeView || s.GroupBy != "" {
 			if level, ok := treeIndents[proc.Pid]; ok {
 				prefix := strings.Repeat("  ", level)
 
 				indicator := ""
-				if s.CollapsedPids[proc.Pid] {
+				if s.TreeView && s.CollapsedPids[proc.Pid] {
 					indicator = "▶ "
 				} else {
 					if level > 0 {
@@ -157,7 +207,9 @@ func RenderProcesses(s *data.AppState, visibleProcs []data.ProcessInfo, treeInde
 			status = "running"
 		}
 
-		if s.SuspendedState[proc.Pid] {
+		if proc.Group != nil {
+			status = fmt.Sprintf("%d procs", proc.Group.Count)
+		} else if s.SuspendedState[proc.Pid] {
 			status = "SUSPENDED"
 		}
 
@@ -166,11 +218,24 @@ func RenderProcesses(s *data.AppState, visibleProcs []data.ProcessInfo, treeInde
 		}
 
 		// Apply widths via style
-		pidCell := currCellStyle.Width(pidWidth).Render(fmt.Sprintf("%d", proc.Pid))
+		mark := "  "
+		if s.MarkedPids[proc.Pid] {
+			mark = "● "
+		}
+		pidStr := fmt.Sprintf("%s%d", mark, proc.Pid)
 		nameCell := currCellStyle.Width(nameWidth).Render(name)
+		if proc.Group != nil {
+			// Group rows have no PID; show whether all members are marked
+			pidStr = "  "
+			if groupMarked(s, proc.Group) {
+				pidStr = "● "
+			}
+			nameCell = currCellStyle.Bold(true).Width(nameWidth).Render(name)
+		}
+		pidCell := currCellStyle.Width(pidWidth).Render(pidStr)
 
 		var statusStr string
-		if s.SuspendedState[proc.Pid] {
+		if proc.Group == nil && s.SuspendedState[proc.Pid] {
 			warnStyle := currCellStyle.Foreground(lipgloss.Color("#F59E0B"))
 			if isSelected {
 				warnStyle = warnStyle.Background(selColor)
@@ -185,6 +250,7 @@ func RenderProcesses(s *data.AppState, visibleProcs []data.ProcessInfo, treeInde
 
 		cpuCell := cpuStyle.Width(cpuWidth).Align(lipgloss.Right).Render(cpuStr)
 		memCell := memStyle.Width(memWidth).Align(lipgloss.Right).Render(memStr)
+		ioCell := currCellStyle.Width(ioWidth).Align(lipgloss.Right).Render(formatIORate(proc.IOReadRate + proc.IOWriteRate))
 
 		space := " "
 		if isSelected {
@@ -192,7 +258,19 @@ func RenderProcesses(s *data.AppState, visibleProcs []data.ProcessInfo, treeInde
 		}
 
 		// Compose row
-		rowContent := pidCell + space + nameCell + space + statusStr + space + cpuCell + space + memCell
+		rowContent := pidCell + space + nameCell + space
+		if containerWidth > 0 {
+			ctr := proc.Container
+			if len(ctr) > containerWidth-1 {
+				ctr = ctr[:containerWidth-2] + "…"
+			}
+			rowContent += currCellStyle.Width(containerWidth).Render(ctr) + space
+		}
+		rowContent += statusStr + space + cpuCell + space + memCell + space + ioCell
+		if netWidth > 0 {
+			rowContent += space + currCellStyle.Width(netWidth).Align(lipgloss.Right).Render(formatNetRate(s, proc.NetRxRate)) +
+				space + currCellStyle.Width(netWidth).Align(lipgloss.Right).Render(formatNetRate(s, proc.NetTxRate))
+		}
 
 		row := lipgloss.NewStyle().Width(contentWidth).Render(rowContent)
 
@@ -210,6 +288,12 @@ func RenderProcesses(s *data.AppState, visibleProcs []data.ProcessInfo, treeInde
 	content := lipgloss.JoinVertical(lipgloss.Left, lipgloss.NewStyle().Width(contentWidth).Render(headerRow), "", strings.Join(rows, "\n"))
 
 	titleText := fmt.Sprintf("PROCESSES (Sort: %s)%s", strings.ToUpper(s.SortBy), scrollInfo)
+	if s.GroupBy != "" {
+		titleText = fmt.Sprintf("PROCESSES (Sort: %s, Group: %s)%s", strings.ToUpper(s.SortBy), strings.ToUpper(s.GroupBy), scrollInfo)
+	}
+	if len(s.MarkedPids) > 0 {
+		titleText += fmt.Sprintf(" [Marked: %d]", len(s.MarkedPids))
+	}
 
 	listContentHeight := listHeight - 2
 	if listContentHeight < 0 {
@@ -226,8 +310,12 @@ func RenderProcesses(s *data.AppState, visibleProcs []data.ProcessInfo, treeInde
 
 	var filterIndicator string
 	if s.FilterMode {
+		filterColor := p
+		if s.FilterError != "" {
+			filterColor = a
+		}
 		filterIndicator = lipgloss.NewStyle().
-			Foreground(p).
+			Foreground(filterColor).
 			Bold(true).
 			MarginLeft(2).
 			Render(fmt.Sprintf(" Filter: %s█", s.ProcessFilter))
@@ -257,6 +345,10 @@ func renderProcessDetails(s *data.AppState, proc *data.ProcessInfo, container li
 		return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
 	}
 
+	if proc.Group != nil {
+		return renderGroupDetails(s, proc, container, boxWidth, contentHeight, t, mu, p, b, su, w, a)
+	}
+
 	labelStyle := lipgloss.NewStyle().Foreground(mu)
 	valueStyle := lipgloss.NewStyle().Foreground(t).Bold(true)
 	contentWidth := boxWidth - 4
@@ -319,3 +411,91 @@ func renderProcessDetails(s *data.AppState, proc *data.ProcessInfo, container li
 
 	return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
 }
+
+// renderGroupDetails renders the details panel for a selected group row
+func renderGroupDetails(s *data.AppState, row *data.ProcessInfo, container lipgloss.Style, boxWidth, contentHeight int, t, mu, p, b, su, w, a compat.AdaptiveColor) string {
+	border := widgets.GetBorder(s.BorderStyle, s.BorderType)
+	g := row.Group
+
+	labelStyle := lipgloss.NewStyle().Foreground(mu)
+	valueStyle := lipgloss.NewStyle().Foreground(t).Bold(true)
+	contentWidth := boxWidth - 4
+
+	col1Width := contentWidth / 3
+	col2Width := contentWidth / 3
+	col3Width := contentWidth - col1Width - col2Width
+
+	key := g.Key
+	if len(key) > col1Width-6 && col1Width > 9 {
+		key = "..." + key[len(key)-(col1Width-9):]
+	}
+
+	// Count members in each state
+	running, sleeping, other := 0, 0, 0
+	for _, m := range g.Members {
+		switch {
+		case strings.HasPrefix(m.Status, "running"), m.Status == "":
+			running++
+		case strings.HasPrefix(m.Status, "sleep"), strings.HasPrefix(m.Status, "idle"):
+			sleeping++
+		default:
+			other++
+		}
+	}
+
+	// Container rows carry cgroup totals rather than member sums
+	cpuColor := widgets.GetColorForValue(row.Cpu, su, w, a)
+	memColor := widgets.GetColorForValue(row.Memory, su, w, a)
+	source := ""
+	if row.ContainerID != "" {
+		source = " (cgroup)"
+	}
+
+	leftCol := lipgloss.JoinVertical(lipgloss.Left,
+		labelStyle.Render(strings.Title(s.GroupBy)+": ")+valueStyle.Render(key),
+		labelStyle.Render("Processes: ")+valueStyle.Render(fmt.Sprintf("%d", g.Count)),
+		labelStyle.Render("States: ")+valueStyle.Render(fmt.Sprintf("%d run, %d sleep, %d other", running, sleeping, other)),
+	)
+
+	midCol := lipgloss.JoinVertical(lipgloss.Left,
+		labelStyle.Render("I/O Read: ")+valueStyle.Render(formatIORate(row.IOReadRate)),
+		labelStyle.Render("I/O Write: ")+valueStyle.Render(formatIORate(row.IOWriteRate)),
+		labelStyle.Render("Avg CPU: ")+valueStyle.Render(fmt.Sprintf("%.1f%%", g.Cpu/float64(g.Count))),
+	)
+
+	rightCol := lipgloss.JoinVertical(lipgloss.Left,
+		labelStyle.Render("CPU"+source+": ")+lipgloss.NewStyle().Foreground(cpuColor).Bold(true).Render(fmt.Sprintf("%.1f%%", row.Cpu)),
+		labelStyle.Render("Memory"+source+": ")+lipgloss.NewStyle().Foreground(memColor).Bold(true).Render(fmt.Sprintf("%.1f%% (%s)", row.Memory, utils.FormatBytes(row.MemoryBytes))),
+		labelStyle.Render("Avg Memory: ")+valueStyle.Render(utils.FormatBytes(g.MemoryBytes/uint64(g.Count))),
+	)
+
+	details := lipgloss.JoinHorizontal(lipgloss.Top,
+		lipgloss.NewStyle().Width(col1Width).Render(leftCol),
+		lipgloss.NewStyle().Width(col2Width).Render(midCol),
+		lipgloss.NewStyle().Width(col3Width).Render(rightCol),
+	)
+
+	c := container.Width(boxWidth).Height(contentHeight).BorderTop(false)
+	body := c.Render(details)
+	topBorder := widgets.RenderTopBorderWithBg("GROUP DETAILS", boxWidth, border, b, p)
+
+	return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+}
+
+// groupMarked reports whether every member of a group is marked
+func groupMarked(s *data.AppState, g *data.ProcessGroup) bool {
+	for _, m := range g.Members {
+		if !s.MarkedPids[m.Pid] {
+			return false
+		}
+	}
+	return len(g.Members) > 0
+}
+
+// formatIORate formats a bytes/s rate for the I/O column
+func formatIORate(rate float64) string {
+	if rate < 1 {
+		return "-"
+	}
+	return utils.FormatBytes(uint64(rate)) + "/s"
+}
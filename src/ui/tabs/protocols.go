@@ -0,0 +1,141 @@
+package tabs
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/ui/widgets"
+)
+
+// RenderProtocols renders the Protocols view of the Network tab: TCP, UDP
+// and IP counter rates with their history
+func RenderProtocols(s *data.AppState, container lipgloss.Style, su, w, a, t, mu, p, b compat.AdaptiveColor, availHeight int) string {
+	boxWidth := s.Width
+	contentWidth := boxWidth - 4
+	border := widgets.GetBorder(s.BorderStyle, s.BorderType)
+
+	contentHeight := availHeight - 2
+	if contentHeight < 0 {
+		contentHeight = 0
+	}
+
+	render := func(title, content string) string {
+		c := container.Width(boxWidth).Height(contentHeight).BorderTop(false)
+		body := c.Render(content)
+		topBorder := widgets.RenderTopBorderWithBg(title, boxWidth, border, b, p)
+		return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+	}
+
+	stats := s.ProtocolStats
+	if !stats.Available {
+		msg := "Loading protocol counters..."
+		if !stats.Time.IsZero() {
+			msg = "Protocol counters are only available on Linux (/proc/net/snmp)"
+		}
+		return render("PROTOCOLS", lipgloss.NewStyle().Foreground(mu).Render(msg))
+	}
+
+	textStyle := lipgloss.NewStyle().Foreground(t)
+	mutedStyle := lipgloss.NewStyle().Foreground(mu)
+
+	// Summary: open connections and the share of segments retransmitted
+	summary := textStyle.Bold(true).Render("TCP ") +
+		textStyle.Render(fmt.Sprintf("%d established", stats.Counters["Tcp.CurrEstab"]))
+	if out := s.ProtocolRates["Tcp.OutSegs"]; out > 0 {
+		retrans := s.ProtocolRates["Tcp.RetransSegs"] / out * 100
+		color := su
+		switch {
+		case retrans >= 5:
+			color = a
+		case retrans >= 1:
+			color = w
+		}
+		summary += mutedStyle.Render(" • ") +
+			lipgloss.NewStyle().Foreground(color).Bold(true).Render(fmt.Sprintf("%.2f%%", retrans)) +
+			textStyle.Render(fmt.Sprintf(" of %s segments/s retransmitted", formatCountRate(out)))
+	}
+	summaryLine := lipgloss.NewStyle().Width(contentWidth).MaxHeight(1).Render(summary)
+
+	labelWidth := 26
+	rateWidth := 9
+	totalWidth := 12
+	alertWidth := 8
+	histWidth := contentWidth - labelWidth - 2*rateWidth - totalWidth - alertWidth - 5
+	showHistory := histWidth >= 10
+
+	hdrStyle := lipgloss.NewStyle().Bold(true).Underline(true)
+	headerRow := hdrStyle.Width(labelWidth).Render("COUNTER") + " " +
+		hdrStyle.Width(rateWidth).Align(lipgloss.Right).Render("RATE/s") + " " +
+		hdrStyle.Width(rateWidth).Align(lipgloss.Right).Render("PEAK/s") + " " +
+		hdrStyle.Width(totalWidth).Align(lipgloss.Right).Render("SINCE BOOT") + " " +
+		hdrStyle.Width(alertWidth).Align(lipgloss.Right).Render("ALERT")
+	if showHistory {
+		headerRow += " " + hdrStyle.Width(histWidth).Render("HISTORY")
+	}
+
+	var rows []string
+	for _, counter := range data.ProtocolCounters {
+		total, ok := stats.Counters[counter.Key]
+		if !ok {
+			// Not reported by this kernel
+			continue
+		}
+		rate := s.ProtocolRates[counter.Key]
+		hist := s.ProtocolHistory[counter.Key]
+		peak := 0.0
+		if hist != nil {
+			peak = hist.Max()
+		}
+
+		threshold := 0.0
+		if counter.Metric != "" {
+			threshold = s.Config.Thresholds[counter.Metric]
+		}
+		alert := "-"
+		if threshold > 0 {
+			alert = fmt.Sprintf(">%g", threshold)
+		}
+
+		color := t
+		switch {
+		case threshold > 0 && rate > threshold:
+			color = a
+		case counter.Error && rate >= 1:
+			color = w
+		}
+		chartColor := p
+		if counter.Error {
+			chartColor = w
+		}
+
+		row := textStyle.Width(labelWidth).Render(counter.Label) + " " +
+			lipgloss.NewStyle().Foreground(color).Bold(color != t).Width(rateWidth).Align(lipgloss.Right).Render(formatCountRate(rate)) + " " +
+			mutedStyle.Width(rateWidth).Align(lipgloss.Right).Render(formatCountRate(peak)) + " " +
+			mutedStyle.Width(totalWidth).Align(lipgloss.Right).Render(fmt.Sprintf("%d", total)) + " " +
+			mutedStyle.Width(alertWidth).Align(lipgloss.Right).Render(alert)
+		if showHistory && hist != nil && hist.Len() > 0 {
+			row += " " + widgets.RenderSparkline(hist, histWidth, 1, chartColor, chartColor)
+		}
+		rows = append(rows, row)
+	}
+
+	visibleRows := contentHeight - 3
+	if visibleRows < 1 {
+		visibleRows = 1
+	}
+	if len(rows) > visibleRows {
+		rows = rows[:visibleRows]
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		summaryLine,
+		"",
+		lipgloss.NewStyle().Width(contentWidth).Render(headerRow),
+		strings.Join(rows, "\n"),
+	)
+	return render("PROTOCOLS (/proc/net/snmp, /proc/net/netstat)", content)
+}
@@ -0,0 +1,170 @@
+package tabs
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/compat"
+
+	"github.com/N1xev/bubbleMonitor/src/data"
+	"github.com/N1xev/bubbleMonitor/src/ui/widgets"
+	"github.com/N1xev/bubbleMonitor/src/utils"
+)
+
+// RenderServices renders the systemd services tab
+func RenderServices(s *data.AppState, container lipgloss.Style, su, w, a, t, mu, p, b compat.AdaptiveColor, availHeight int) string {
+	boxWidth := s.Width
+	contentWidth := boxWidth - 4
+	border := widgets.GetBorder(s.BorderStyle, s.BorderType)
+
+	contentHeight := availHeight - 2
+	if contentHeight < 0 {
+		contentHeight = 0
+	}
+
+	render := func(title, content string) string {
+		c := container.Width(boxWidth).Height(contentHeight).BorderTop(false)
+		body := c.Render(content)
+		topBorder := widgets.RenderTopBorderWithBg(title, boxWidth, border, b, p)
+		return lipgloss.JoinVertical(lipgloss.Left, topBorder, body)
+	}
+
+	if s.ServicesErr != "" {
+		msg := lipgloss.JoinVertical(lipgloss.Left,
+			lipgloss.NewStyle().Foreground(a).Bold(true).Render("Cannot list services"),
+			"",
+			lipgloss.NewStyle().Foreground(t).Render(s.ServicesErr),
+		)
+		return render("SERVICES", msg)
+	}
+	if s.Services == nil {
+		return render("SERVICES", lipgloss.NewStyle().Foreground(mu).Render("Loading services..."))
+	}
+	if len(s.Services) == 0 {
+		return render("SERVICES", lipgloss.NewStyle().Foreground(mu).Render("No services"))
+	}
+
+	activeWidth := 12
+	subWidth := 10
+	pidWidth := 8
+	cpuWidth := 8
+	memWidth := 10
+	restartWidth := 4
+	unitWidth := 32
+	descWidth := contentWidth - unitWidth - activeWidth - subWidth - pidWidth - cpuWidth - memWidth - restartWidth - 8
+	if descWidth < 10 {
+		// Narrow terminal: give the unit name what is left and hide the description
+		unitWidth += descWidth + 1
+		descWidth = 0
+		if unitWidth < 16 {
+			unitWidth = 16
+		}
+	}
+
+	hdrStyle := lipgloss.NewStyle().Bold(true).Underline(true)
+	headerRow := hdrStyle.Width(unitWidth).Render("UNIT") + " " +
+		hdrStyle.Width(activeWidth).Render("ACTIVE") + " " +
+		hdrStyle.Width(subWidth).Render("SUB") + " " +
+		hdrStyle.Width(pidWidth).Align(lipgloss.Right).Render("PID") + " " +
+		hdrStyle.Width(cpuWidth).Align(lipgloss.Right).Render("CPU") + " " +
+		hdrStyle.Width(memWidth).Align(lipgloss.Right).Render("MEM") + " " +
+		hdrStyle.Width(restartWidth).Align(lipgloss.Right).Render("RST")
+	if descWidth > 0 {
+		headerRow += " " + hdrStyle.Width(descWidth).Render("DESCRIPTION")
+	}
+
+	visibleRows := contentHeight - 2
+	if visibleRows < 1 {
+		visibleRows = 1
+	}
+	startIdx := s.ServiceScrollOffset
+	if startIdx > len(s.Services)-1 {
+		startIdx = 0
+	}
+	endIdx := startIdx + visibleRows
+	if endIdx > len(s.Services) {
+		endIdx = len(s.Services)
+	}
+
+	selColor := compat.AdaptiveColor{Light: lipgloss.Color("#E0E7FF"), Dark: lipgloss.Color("#3730A3")}
+	trunc := func(str string, width int) string {
+		if len(str) > width-1 {
+			return str[:width-2] + "…"
+		}
+		return str
+	}
+
+	var rows []string
+	for i := startIdx; i < endIdx; i++ {
+		svc := s.Services[i]
+		isSelected := i == s.SelectedService
+
+		cell := lipgloss.NewStyle()
+		if isSelected {
+			cell = cell.Background(selColor)
+		}
+
+		activeColor := mu
+		switch svc.ActiveState {
+		case "active":
+			activeColor = su
+		case "activating", "deactivating", "reloading":
+			activeColor = w
+		case "failed":
+			activeColor = a
+		}
+
+		pid, cpu, mem := "-", "-", "-"
+		cpuColor := mu
+		if svc.MainPid > 0 {
+			pid = fmt.Sprintf("%d", svc.MainPid)
+		}
+		if svc.ActiveState == "active" {
+			cpuColor = widgets.GetColorForValue(svc.CpuPercent, su, w, a)
+			cpu = fmt.Sprintf("%.1f%%", svc.CpuPercent)
+		}
+		if svc.MemoryCurrent > 0 {
+			mem = utils.FormatBytes(svc.MemoryCurrent)
+		}
+
+		restartColor := t
+		if svc.NRestarts > 0 {
+			restartColor = w
+		}
+
+		sp := cell.Render(" ")
+		row := cell.Bold(true).Width(unitWidth).Render(trunc(strings.TrimSuffix(svc.Unit, ".service"), unitWidth)) + sp +
+			cell.Foreground(activeColor).Width(activeWidth).Render(svc.ActiveState) + sp +
+			cell.Foreground(activeColor).Width(subWidth).Render(trunc(svc.SubState, subWidth)) + sp +
+			cell.Width(pidWidth).Align(lipgloss.Right).Render(pid) + sp +
+			cell.Foreground(cpuColor).Width(cpuWidth).Align(lipgloss.Right).Render(cpu) + sp +
+			cell.Width(memWidth).Align(lipgloss.Right).Render(mem) + sp +
+			cell.Foreground(restartColor).Width(restartWidth).Align(lipgloss.Right).Render(fmt.Sprintf("%d", svc.NRestarts))
+		if descWidth > 0 {
+			row += sp + cell.Foreground(mu).Width(descWidth).Render(trunc(svc.Description, descWidth))
+		}
+
+		rows = append(rows, cell.Width(contentWidth).Render(row))
+	}
+
+	active, failed := 0, 0
+	for _, svc := range s.Services {
+		switch svc.ActiveState {
+		case "active":
+			active++
+		case "failed":
+			failed++
+		}
+	}
+	titleText := fmt.Sprintf("SERVICES (%d active, %d failed, %d total)", active, failed, len(s.Services))
+	if len(s.Services) > visibleRows {
+		titleText += fmt.Sprintf(" [%d-%d of %d]", startIdx+1, endIdx, len(s.Services))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.NewStyle().Width(contentWidth).Render(headerRow),
+		strings.Join(rows, "\n"),
+	)
+	return render(titleText, content)
+}
@@ -2,7 +2,6 @@ package tabs
 
 import (
 	"fmt"
-	"runtime"
 	"time"
 
 	"charm.land/lipgloss/v2"
@@ -56,9 +55,14 @@ func RenderSystem(s *data.AppState, container, titleStyle, labelStyle, valueStyl
 	if len(s.CpuInfoStatic) > 0 {
 		cpuModel = s.CpuInfoStatic[0].ModelName
 	}
+	topo := s.Topology
+	cores := "..."
+	if topo.Logical > 0 {
+		cores = fmt.Sprintf("%d physical, %d logical", topo.Cores, topo.Logical)
+	}
 	cpuInfo := lipgloss.JoinVertical(lipgloss.Left,
 		fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render("Model:")+sp("    "), valueStyle.Render(cpuModel)), idx),
-		fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render("Cores:")+sp("    "), valueStyle.Render(fmt.Sprintf("%d physical,", runtime.NumCPU()))+sp(" ")+valueStyle.Render(fmt.Sprintf("%d logical", runtime.GOMAXPROCS(0)))), idx),
+		fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render("Cores:")+sp("    "), valueStyle.Render(cores)), idx),
 	)
 
 	// Uptime & Load (Index 2)
@@ -81,6 +85,13 @@ func RenderSystem(s *data.AppState, container, titleStyle, labelStyle, valueStyl
 		fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render("Load 5m:"+sp("   ")), valueStyle.Render(l5)), idx),
 		fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render("Load 15m:"+sp("  ")), valueStyle.Render(l15)), idx),
 	)
+	if s.Pressure.Available {
+		// Share of time tasks stalled on each resource (10s average)
+		psi := fmt.Sprintf("cpu %.1f%% mem %.1f%% io %.1f%%", s.Pressure.CPU.Some.Avg10, s.Pressure.Memory.Some.Avg10, s.Pressure.IO.Some.Avg10)
+		uptimeLoad = lipgloss.JoinVertical(lipgloss.Left, uptimeLoad,
+			fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render("Pressure:"+sp("  ")), valueStyle.Render(psi)), idx),
+		)
+	}
 
 	// Uptime (Index 3)
 	idx = 3
@@ -121,9 +132,78 @@ func RenderSystem(s *data.AppState, container, titleStyle, labelStyle, valueStyl
 		)
 	}
 
+	// Topology (sysfs only): sockets, SMT and caches
+	idx = 6
+	var topoInfo string
+	if topo.Available {
+		smt := 1
+		if topo.Cores > 0 {
+			smt = topo.Logical / topo.Cores
+		}
+		lines := []string{
+			fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render("Sockets:"+sp("  ")), valueStyle.Render(fmt.Sprintf("%d", topo.Sockets))), idx),
+			fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render("Cores:"+sp("    ")), valueStyle.Render(fmt.Sprintf("%d per socket", topo.Cores/max(topo.Sockets, 1)))), idx),
+			fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render("SMT:"+sp("      ")), valueStyle.Render(fmt.Sprintf("%d thread(s) per core", smt))), idx),
+		}
+		for _, c := range topo.Caches {
+			name := fmt.Sprintf("L%d", c.Level)
+			switch c.Type {
+			case "Data":
+				name += "d"
+			case "Instruction":
+				name += "i"
+			}
+			lines = append(lines, fwLine(lipgloss.JoinHorizontal(lipgloss.Top,
+				labelStyle.Render(fmt.Sprintf("%-10s", name+":")),
+				valueStyle.Render(fmt.Sprintf("%s × %d", utils.FormatBytes(c.Size), c.Instances))), idx))
+		}
+		topoInfo = lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
+
+	// NUMA nodes with their memory and numastat counters
+	idx = 7
+	var numaInfo string
+	if len(topo.Nodes) > 0 {
+		var lines []string
+		for _, node := range topo.Nodes {
+			// Share of allocations that landed on the node they were meant for
+			local := 100.0
+			if total := node.NumaHit + node.NumaMiss; total > 0 {
+				local = float64(node.NumaHit) / float64(total) * 100
+			}
+			label := labelStyle.Render(fmt.Sprintf("%-10s", fmt.Sprintf("Node %d:", node.ID)))
+			mem := utils.FormatBytes(node.MemUsed) + " / " + utils.FormatBytes(node.MemTotal)
+			if len(topo.Nodes) > 3 {
+				// One line per node on large machines
+				lines = append(lines, fwLine(lipgloss.JoinHorizontal(lipgloss.Top, label,
+					valueStyle.Render(mem), labelStyle.Render(fmt.Sprintf(" %.1f%% local", local))), idx))
+				continue
+			}
+			lines = append(lines,
+				fwLine(lipgloss.JoinHorizontal(lipgloss.Top, label, valueStyle.Render("CPUs "+node.CPUList)), idx),
+				fwLine(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render(sp("  ")+"Mem:"+sp("    ")), valueStyle.Render(mem)), idx),
+				fwLine(lipgloss.JoinHorizontal(lipgloss.Top,
+					labelStyle.Render(sp("  ")+"Local:"+sp("  ")),
+					valueStyle.Render(fmt.Sprintf("%.1f%%", local)),
+					labelStyle.Render(fmt.Sprintf(" miss %d foreign %d", node.NumaMiss, node.NumaForeign))), idx),
+			)
+		}
+		numaInfo = lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
+
 	blocks := []string{hostInfo, cpuInfo, uptimeLoad, uptime, memInfo}
+	titles := []string{"HOST", "CPU", "SYSTEM LOAD", "TIMINGS", "MEMORY"}
 	if gpuInfo != "" {
 		blocks = append(blocks, gpuInfo)
+		titles = append(titles, "GPU")
+	}
+	if topoInfo != "" {
+		blocks = append(blocks, topoInfo)
+		titles = append(titles, "TOPOLOGY")
+	}
+	if numaInfo != "" {
+		blocks = append(blocks, numaInfo)
+		titles = append(titles, "NUMA")
 	}
 
 	numRows := (len(blocks) + cols - 1) / cols
@@ -133,11 +213,6 @@ func RenderSystem(s *data.AppState, container, titleStyle, labelStyle, valueStyl
 		contentHeight = 1
 	}
 
-	titles := []string{"HOST", "CPU", "SYSTEM LOAD", "TIMINGS", "MEMORY"}
-	if gpuInfo != "" {
-		titles = append(titles, "GPU")
-	}
-
 	var renderedBlocks []string
 	for i, block := range blocks {
 		title := titles[i]
@@ -10,8 +10,17 @@ import (
 	"github.com/N1xev/bubbleMonitor/src/data"
 )
 
+// Bounded is implemented by accessors with a fixed full-scale value.
+// Charts draw them against that value instead of their own peak.
+type Bounded interface {
+	Bound() float64
+}
+
 // Helper to get max value from Accessor
 func maxFromAccessor(a data.Accessor) float64 {
+	if b, ok := a.(Bounded); ok && b.Bound() > 0 {
+		return b.Bound()
+	}
 	if a.Len() == 0 {
 		return 0
 	}
@@ -134,6 +143,9 @@ func RenderBarChart(data data.Accessor, width, height int, c1, c2 compat.Adaptiv
 	for i := startIdx; i < data.Len(); i++ {
 		val := data.Get(i)
 		barLen := int((val / maxV) * float64(width-8))
+		if barLen > width-8 {
+			barLen = width - 8
+		}
 		if barLen < 0 {
 			barLen = 0
 		}
@@ -284,3 +296,64 @@ func RenderTTYChart(data data.Accessor, width, height int, c1, c2 compat.Adaptiv
 
 	return strings.Join(lines, "\n")
 }
+
+// RenderStackedChart draws percentage series (0-100) stacked on top of each
+// other, the first series at the bottom. Each cell takes the color of the
+// series covering its middle.
+func RenderStackedChart(series []data.Accessor, colors []compat.AdaptiveColor, width, height int) string {
+	if len(series) == 0 || series[0].Len() == 0 || height < 1 {
+		return "No data"
+	}
+
+	n := series[0].Len()
+	startIdx := 0
+	if n > width {
+		startIdx = n - width
+	}
+
+	styles := make([]lipgloss.Style, len(colors))
+	for i, c := range colors {
+		styles[i] = lipgloss.NewStyle().Foreground(c)
+	}
+
+	// cells[row][col] is the series index filling that cell, or -1
+	cells := make([][]int, height)
+	for r := range cells {
+		cells[r] = make([]int, width)
+		for c := range cells[r] {
+			cells[r][c] = -1
+		}
+	}
+	for col := 0; col < width && startIdx+col < n; col++ {
+		top := 0.0
+		for si, sd := range series {
+			if startIdx+col >= sd.Len() {
+				continue
+			}
+			bottom := top
+			top += sd.Get(startIdx+col) / 100 * float64(height)
+			for row := 0; row < height; row++ {
+				// Rows count from the bottom; test the cell's midpoint
+				mid := float64(row) + 0.5
+				if mid >= bottom && mid < top {
+					cells[height-1-row][col] = si
+				}
+			}
+		}
+	}
+
+	lines := make([]string, height)
+	for r := 0; r < height; r++ {
+		var line strings.Builder
+		for c := 0; c < width; c++ {
+			si := cells[r][c]
+			if si < 0 || si >= len(styles) {
+				line.WriteString(" ")
+				continue
+			}
+			line.WriteString(styles[si].Render("█"))
+		}
+		lines[r] = line.String()
+	}
+	return strings.Join(lines, "\n")
+}
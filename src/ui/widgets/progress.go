@@ -40,3 +40,27 @@ func RenderProgressBar(val float64, width int, su, w, a compat.AdaptiveColor) st
 
 	return filledStyle.Render(strings.Repeat("█", filled)) + emptyStyle.Render(strings.Repeat("░", empty))
 }
+
+// RenderStackedBar renders values (percent, summing to at most 100) as
+// consecutive colored segments of one bar
+func RenderStackedBar(values []float64, colors []compat.AdaptiveColor, width int) string {
+	var sb strings.Builder
+	used := 0
+	cum := 0.0
+	for i, v := range values {
+		if i >= len(colors) || v <= 0 {
+			continue
+		}
+		// Round the running total so segments never drift past the width
+		cum += v
+		end := int(cum/100*float64(width) + 0.5)
+		if end > width {
+			end = width
+		}
+		if end > used {
+			sb.WriteString(lipgloss.NewStyle().Foreground(colors[i]).Render(strings.Repeat("█", end-used)))
+			used = end
+		}
+	}
+	return sb.String() + emptyStyle.Render(strings.Repeat("░", width-used))
+}
@@ -19,6 +19,21 @@ func FormatBytes(bytes uint64) string {
 	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
 }
 
+// FormatBits formats a bit count with decimal prefixes, as link speeds are
+// quoted (1 Kb = 1000 b)
+func FormatBits(bits float64) string {
+	const unit = 1000
+	if bits < unit {
+		return fmt.Sprintf("%.0f b", bits)
+	}
+	div, exp := float64(unit), 0
+	for n := bits / unit; n >= unit && exp < 5; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cb", bits/div, "KMGTPE"[exp])
+}
+
 // FormatDuration formats a duration into human-readable format
 func FormatDuration(d time.Duration) string {
 	days := int(d.Hours() / 24)
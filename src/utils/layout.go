@@ -2,6 +2,7 @@ package utils
 
 import (
 	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // CalculateColumnWidths distributes the total width among n columns
@@ -25,3 +26,10 @@ func CalculateColumnWidths(totalWidth, n int) []int {
 func FullWidthBg(s string, width int) string {
 	return lipgloss.NewStyle().Width(width).Render(s)
 }
+
+// Truncate shortens a string to at most width cells, ending it with an
+// ellipsis when cut. Widths are display widths, so multi-byte and wide
+// characters are never split.
+func Truncate(s string, width int) string {
+	return ansi.Truncate(s, width, "…")
+}
@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"os/user"
+	"sync"
+)
+
+// usernames caches uid -> user name lookups
+var usernames sync.Map
+
+// LookupUsername resolves a uid to a user name, caching the result. A uid
+// without a user is returned as is.
+func LookupUsername(uid string) string {
+	if name, ok := usernames.Load(uid); ok {
+		return name.(string)
+	}
+	name := uid
+	if u, err := user.LookupId(uid); err == nil {
+		name = u.Username
+	}
+	usernames.Store(uid, name)
+	return name
+}